@@ -3,6 +3,9 @@ package splunk
 import (
 	"encoding/json"
 	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 type ACL struct {
@@ -78,4 +81,67 @@ func (c *Client) ACLPost(acl *ACL, path string) (f ACLFeed, e error) {
 
 	json.Unmarshal(b, &f)
 	return
+}
+
+// aclSchema is the acl block of knowledge objects whose app and owner
+// namespace are set through top level arguments.
+func aclSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"app": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"sharing": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice([]string{"user", "app", "global"}, false),
+				},
+				"read": {
+					Type: schema.TypeList,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional: true,
+					Computed: true,
+				},
+				"write": {
+					Type: schema.TypeList,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// aclFromResourceData returns the configured acl block, or nil when none is set.
+func aclFromResourceData(d *schema.ResourceData) *ACL {
+	a := d.Get("acl").([]interface{})
+	if len(a) == 0 || a[0] == nil {
+		return nil
+	}
+	m := a[0].(map[string]interface{})
+
+	acl := &ACL{
+		Owner:   m["owner"].(string),
+		Sharing: m["sharing"].(string),
+	}
+	acl.Perms.Read = stringArrayFromInterface(m["read"].([]interface{}))
+	acl.Perms.Write = stringArrayFromInterface(m["write"].([]interface{}))
+	return acl
 }
\ No newline at end of file
@@ -0,0 +1,186 @@
+package splunk
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// AuthSettingsRead returns the authentication type and the strategies in the
+// order they are tried, as listed by authSettings in the [authentication]
+// stanza.
+func (c *Client) AuthSettingsRead() (authType string, strategies []string, e error) {
+	o, e := c.ConfStanzaRead("nobody", "system", "authentication", "authentication")
+	if e != nil {
+		return
+	}
+
+	return contentString(o.Content, "authType"), contentStringList(o.Content, "authSettings"), nil
+}
+
+// AuthSettingsMove moves an LDAP strategy to the given 1-based position of
+// authSettings, see moveStrategy, and switches native Splunk authentication to
+// LDAP. While SAML is in use, authSettings only names its strategy and is left
+// alone.
+func (c *Client) AuthSettingsMove(strategy string, position int) (e error) {
+	currentType, strategies, e := c.AuthSettingsRead()
+	if e != nil {
+		return
+	}
+	if currentType == "SAML" {
+		return
+	}
+
+	return c.authSettingsUpdate(currentType, "LDAP", strategies, moveStrategy(strategies, strategy, position))
+}
+
+// AuthSettingsUpdate switches the authentication type and the strategies used.
+func (c *Client) AuthSettingsUpdate(authType string, strategies []string) (e error) {
+	currentType, current, e := c.AuthSettingsRead()
+	if e != nil {
+		return
+	}
+
+	return c.authSettingsUpdate(currentType, authType, current, strategies)
+}
+
+// AuthSettingsRestore puts back the authentication type and strategies used
+// before the given SAML strategy took over, leaving out the LDAP strategies
+// deleted since. When the settings were changed in the meantime, the strategy
+// is only removed, see AuthSettingsRemove.
+func (c *Client) AuthSettingsRestore(strategy, authType string, strategies []string) (e error) {
+	currentType, current, e := c.AuthSettingsRead()
+	if e != nil {
+		return
+	}
+	if currentType != "SAML" || strings.Join(current, ",") != strategy {
+		return c.AuthSettingsRemove("SAML", strategy)
+	}
+
+	restored := []string{}
+	for _, s := range removeStrategy(strategies, strategy) {
+		if authType == "LDAP" {
+			_, err := c.GetEntry(fmt.Sprintf(PathLDAPStrategy, url.PathEscape(s)))
+			if err != nil && strings.Contains(err.Error(), "404") {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+		restored = append(restored, s)
+	}
+	if len(restored) == 0 {
+		authType = "Splunk"
+	}
+
+	return c.authSettingsUpdate(currentType, authType, current, restored)
+}
+
+// AuthSettingsRemove removes a strategy from authSettings. When authType is
+// the type in use, it falls back to the remaining LDAP strategies if any, else
+// to native Splunk authentication.
+func (c *Client) AuthSettingsRemove(authType, strategy string) (e error) {
+	currentType, strategies, e := c.AuthSettingsRead()
+	if e != nil {
+		return
+	}
+
+	remaining := removeStrategy(strategies, strategy)
+
+	newType := currentType
+	if currentType == authType {
+		newType = "Splunk"
+		if len(remaining) > 0 {
+			newType = "LDAP"
+		}
+	}
+
+	return c.authSettingsUpdate(currentType, newType, strategies, remaining)
+}
+
+// authSettingsUpdate only posts the keys that changed, leaving the stanza
+// untouched when nothing did.
+func (c *Client) authSettingsUpdate(oldType, newType string, oldStrategies, newStrategies []string) (e error) {
+	r := url.Values{}
+	if newType != oldType {
+		r.Set("authType", newType)
+	}
+	if strings.Join(newStrategies, ",") != strings.Join(oldStrategies, ",") {
+		r.Set("authSettings", strings.Join(newStrategies, ","))
+	}
+	if len(r) == 0 {
+		return
+	}
+
+	return c.ConfStanzaUpdate("nobody", "system", "authentication", "authentication", r)
+}
+
+// moveStrategy moves a strategy to the given 1-based position, or last when
+// the position is past the end. A position of 0 keeps the strategy where it
+// is, appending it when missing.
+func moveStrategy(strategies []string, strategy string, position int) []string {
+	if position < 1 {
+		for _, s := range strategies {
+			if s == strategy {
+				return strategies
+			}
+		}
+		position = len(strategies) + 1
+	}
+
+	others := removeStrategy(strategies, strategy)
+
+	i := position - 1
+	if i > len(others) {
+		i = len(others)
+	}
+	ordered := append([]string{}, others[:i]...)
+	ordered = append(ordered, strategy)
+	return append(ordered, others[i:]...)
+}
+
+// removeStrategy returns the strategies without the given one.
+func removeStrategy(strategies []string, strategy string) []string {
+	others := []string{}
+	for _, s := range strategies {
+		if s != strategy {
+			others = append(others, s)
+		}
+	}
+	return others
+}
+
+// LDAPGroupRolesRead returns the roles an LDAP group is mapped to.
+func (c *Client) LDAPGroupRolesRead(strategy, group string) (roles []string, e error) {
+	entry, e := c.GetEntry(fmt.Sprintf(PathLDAPGroup, url.PathEscape(strategy), url.PathEscape(group)))
+	if e != nil {
+		return
+	}
+
+	return contentStringList(entry.Content, "roles"), nil
+}
+
+// LDAPGroupRolesUpdate replaces the roles of an LDAP group, no role unmaps it.
+func (c *Client) LDAPGroupRolesUpdate(strategy, group string, roles []string) (e error) {
+	r := url.Values{}
+	if len(roles) == 0 {
+		r.Set("roles", "")
+	}
+	for _, role := range roles {
+		r.Add("roles", role)
+	}
+
+	_, e = c.Post(fmt.Sprintf(PathLDAPGroup, url.PathEscape(strategy), url.PathEscape(group)), r)
+	return
+}
+
+// SAMLGroupRolesRead returns the roles a SAML group is mapped to.
+func (c *Client) SAMLGroupRolesRead(group string) (roles []string, e error) {
+	entry, e := c.GetEntry(fmt.Sprintf(PathSAMLGroup, url.PathEscape(group)))
+	if e != nil {
+		return
+	}
+
+	return contentStringList(entry.Content, "roles"), nil
+}
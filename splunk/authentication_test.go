@@ -0,0 +1,113 @@
+package splunk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMoveStrategy(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Strategies []string
+		Strategy   string
+		Position   int
+		Expected   []string
+	}{
+		{
+			Name:       "first",
+			Strategies: []string{"corp_ad", "partners"},
+			Strategy:   "backup",
+			Position:   1,
+			Expected:   []string{"backup", "corp_ad", "partners"},
+		},
+		{
+			Name:       "middle",
+			Strategies: []string{"corp_ad", "partners"},
+			Strategy:   "backup",
+			Position:   2,
+			Expected:   []string{"corp_ad", "backup", "partners"},
+		},
+		{
+			Name:       "past the end",
+			Strategies: []string{"corp_ad", "partners"},
+			Strategy:   "backup",
+			Position:   10,
+			Expected:   []string{"corp_ad", "partners", "backup"},
+		},
+		{
+			Name:       "moved down",
+			Strategies: []string{"corp_ad", "partners", "backup"},
+			Strategy:   "corp_ad",
+			Position:   3,
+			Expected:   []string{"partners", "backup", "corp_ad"},
+		},
+		{
+			Name:       "moved up",
+			Strategies: []string{"corp_ad", "partners", "backup"},
+			Strategy:   "backup",
+			Position:   1,
+			Expected:   []string{"backup", "corp_ad", "partners"},
+		},
+		{
+			Name:       "kept in place",
+			Strategies: []string{"corp_ad", "partners"},
+			Strategy:   "corp_ad",
+			Position:   0,
+			Expected:   []string{"corp_ad", "partners"},
+		},
+		{
+			Name:       "appended",
+			Strategies: []string{"corp_ad", "partners"},
+			Strategy:   "backup",
+			Position:   0,
+			Expected:   []string{"corp_ad", "partners", "backup"},
+		},
+		{
+			Name:       "empty",
+			Strategies: nil,
+			Strategy:   "corp_ad",
+			Position:   1,
+			Expected:   []string{"corp_ad"},
+		},
+	}
+
+	for _, tc := range cases {
+		if got := moveStrategy(tc.Strategies, tc.Strategy, tc.Position); !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%s: expected %v, got %v", tc.Name, tc.Expected, got)
+		}
+	}
+}
+
+func TestRemoveStrategy(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Strategies []string
+		Strategy   string
+		Expected   []string
+	}{
+		{
+			Name:       "listed",
+			Strategies: []string{"corp_ad", "corp_sso", "partners"},
+			Strategy:   "corp_sso",
+			Expected:   []string{"corp_ad", "partners"},
+		},
+		{
+			Name:       "missing",
+			Strategies: []string{"corp_ad"},
+			Strategy:   "corp_sso",
+			Expected:   []string{"corp_ad"},
+		},
+		{
+			Name:       "last",
+			Strategies: []string{"corp_sso"},
+			Strategy:   "corp_sso",
+			Expected:   []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		if got := removeStrategy(tc.Strategies, tc.Strategy); !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%s: expected %v, got %v", tc.Name, tc.Expected, got)
+		}
+	}
+}
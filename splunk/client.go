@@ -13,6 +13,7 @@ package splunk
 
 import (
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
@@ -20,12 +21,46 @@ import (
 )
 
 const (
-	PathSavedSearchCreate = "/services/saved/searches"
-	PathSavedSearch       = "/services/saved/searches/%s"
-	PathUserCreate        = "/services/authentication/users"
-	PathUserSearch        = "/services/authentication/users/%s"
-	PathRoleCreate        = "/services/authorization/roles"
-	PathRoleSearch        = "/services/authorization/roles/%s"
+	PathSavedSearchCreate     = "/services/saved/searches"
+	PathSavedSearch           = "/services/saved/searches/%s"
+	PathUserCreate            = "/services/authentication/users"
+	PathUserSearch            = "/services/authentication/users/%s"
+	PathRoleCreate            = "/services/authorization/roles"
+	PathRoleSearch            = "/services/authorization/roles/%s"
+	PathNamespaced            = "/servicesNS/%s/%s/%s"
+	PathLookupTableFiles      = "data/lookup-table-files"
+	PathLookupContents        = "/services/data/lookup_edit/lookup_contents"
+	PathLookupDefinitions     = "data/transforms/lookups"
+	PathKVStoreCollections    = "storage/collections/config"
+	PathKVStoreData           = "storage/collections/data/%s"
+	PathMacros                = "admin/macros"
+	PathEventTypes            = "saved/eventtypes"
+	PathFieldValueTags        = "saved/fvtags"
+	PathPropsExtractions      = "data/props/extractions"
+	PathPropsFieldAliases     = "data/props/fieldaliases"
+	PathPropsCalcFields       = "data/props/calcfields"
+	PathTransformsExtractions = "data/transforms/extractions"
+	PathConfProps             = "configs/conf-props"
+	PathViews                 = "data/ui/views"
+	PathNav                   = "data/ui/nav"
+	PathConf                  = "configs/conf-%s"
+	PathDataModels            = "datamodel/model"
+	PathSummarization         = "admin/summarization"
+	PathWorkflowActions       = "data/ui/workflow-actions"
+	PathPasswords             = "storage/passwords"
+	PathTokens                = "/services/authorization/tokens"
+	PathToken                 = "/services/authorization/tokens/%s"
+	PathLDAPStrategies        = "/services/authentication/providers/LDAP"
+	PathLDAPStrategy          = "/services/authentication/providers/LDAP/%s"
+	PathLDAPGroup             = "/services/authentication/providers/LDAP/%s/groups/%s"
+	PathSAMLStrategies        = "/services/authentication/providers/SAML"
+	PathSAMLStrategy          = "/services/authentication/providers/SAML/%s"
+	PathSAMLGroups            = "/services/admin/SAML-groups"
+	PathSAMLGroup             = "/services/admin/SAML-groups/%s"
+	PathDirectory             = "directory"
+	PathCurrentContext        = "/services/authentication/current-context"
+	PathServerInfo            = "/services/server/info"
+	PathSavedSearches         = "saved/searches"
 )
 
 // Client communicates with the Splunk rest endpoint.
@@ -87,6 +122,31 @@ func (c *Client) Get(path string) (b []byte, e error) {
 	return
 }
 
+// GetEntry returns the first entry of the feed at path.
+func (c *Client) GetEntry(path string) (r Entry, e error) {
+	b, e := c.Get(path)
+	if e != nil {
+		return
+	}
+
+	return firstEntry(b)
+}
+
+// firstEntry decodes a feed and returns its first entry.
+func firstEntry(b []byte) (r Entry, e error) {
+	f := Feed{}
+	e = json.Unmarshal(b, &f)
+	if e != nil {
+		return
+	}
+	if len(f.Entry) == 0 {
+		e = errors.New("Unexpected response from Splunk: 404 no entry found")
+		return
+	}
+
+	return f.Entry[0], nil
+}
+
 func (c *Client) Post(path string, data url.Values) (b []byte, e error) {
 	r, e := c.client.R().
 		SetMultiValueFormData(data).
@@ -104,6 +164,24 @@ func (c *Client) Post(path string, data url.Values) (b []byte, e error) {
 	return
 }
 
+func (c *Client) PostJSON(path string, body interface{}) (b []byte, e error) {
+	r, e := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(body).
+		Post(path)
+	if e != nil {
+		return
+	}
+
+	e = checkStatusCode(r)
+	if e != nil {
+		return
+	}
+
+	b = r.Body()
+	return
+}
+
 func (c *Client) Delete(path string) (e error) {
 	r, e := c.client.R().Delete(path)
 	if e != nil {
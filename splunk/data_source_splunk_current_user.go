@@ -0,0 +1,64 @@
+package splunk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceSplunkCurrentUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSplunkCurrentUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"real_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_app": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"capabilities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSplunkCurrentUserRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	o, err := c.GetEntry(PathCurrentContext)
+	if err != nil {
+		return fmt.Errorf("Failed to read current user: %s", err)
+	}
+
+	d.SetId(contentString(o.Content, "username"))
+	d.Set("username", contentString(o.Content, "username"))
+	d.Set("real_name", contentString(o.Content, "realname"))
+	d.Set("email", contentString(o.Content, "email"))
+	d.Set("default_app", contentString(o.Content, "defaultApp"))
+	d.Set("roles", contentStringList(o.Content, "roles"))
+	d.Set("capabilities", contentStringList(o.Content, "capabilities"))
+	return nil
+}
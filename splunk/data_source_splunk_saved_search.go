@@ -0,0 +1,63 @@
+package splunk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceSplunkSavedSearch() *schema.Resource {
+	s := computedSchema(resourceSplunkSavedSearch().Schema)
+	s["action_email_auth_password"].Sensitive = true
+	s["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s["app"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "-",
+	}
+	s["owner"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "-",
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceSplunkSavedSearchRead,
+		Schema: s,
+	}
+}
+
+func dataSourceSplunkSavedSearchRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	savedSearch, err := c.SavedSearchReadNamespaced(d.Get("owner").(string), d.Get("app").(string), d.Get("name").(string))
+	if err != nil {
+		return fmt.Errorf("Failed to read saved search %s: %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(knowledgeObjectID(savedSearch.ACL.App, savedSearch.Name))
+	setSavedSearchConfiguration(d, &savedSearch.Configuration)
+	return d.Set("acl", flattenAcl(&savedSearch.ACL))
+}
+
+// computedSchema turns a resource schema into the read-only attributes of a
+// data source.
+func computedSchema(m map[string]*schema.Schema) map[string]*schema.Schema {
+	s := make(map[string]*schema.Schema, len(m))
+	for k, v := range m {
+		c := &schema.Schema{
+			Type:      v.Type,
+			Computed:  true,
+			Sensitive: v.Sensitive,
+			Elem:      v.Elem,
+		}
+		if r, ok := v.Elem.(*schema.Resource); ok {
+			c.Elem = &schema.Resource{Schema: computedSchema(r.Schema)}
+		}
+		s[k] = c
+	}
+	return s
+}
@@ -0,0 +1,142 @@
+package splunk
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceSplunkSavedSearches() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSplunkSavedSearchesRead,
+
+		Schema: map[string]*schema.Schema{
+			"search": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"app": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "-",
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "-",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"saved_searches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"app": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"search": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_scheduled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"cron_schedule": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actions": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSplunkSavedSearchesRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	params := url.Values{}
+	params.Set("count", "0")
+	if v, ok := d.GetOk("search"); ok {
+		params.Set("search", v.(string))
+	}
+
+	// already validated
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	app := d.Get("app").(string)
+	f, err := c.SavedSearchList(d.Get("owner").(string), app, "", params)
+	if err != nil {
+		return fmt.Errorf("Failed to list saved searches: %s", err)
+	}
+
+	var names []string
+	var savedSearches []interface{}
+	for _, s := range f.Entry {
+		// the namespace also lists the saved searches shared from other apps
+		if app != "-" && s.ACL.App != app {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(s.Name) {
+			continue
+		}
+
+		names = append(names, s.Name)
+		savedSearches = append(savedSearches, map[string]interface{}{
+			"name":          s.Name,
+			"app":           s.ACL.App,
+			"owner":         s.ACL.Owner,
+			"search":        s.Configuration.Search,
+			"description":   s.Configuration.Description,
+			"disabled":      s.Configuration.Disabled,
+			"is_scheduled":  s.Configuration.IsScheduled,
+			"cron_schedule": s.Configuration.CronSchedule,
+			"actions":       s.Configuration.Actions,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(names, ","))))
+	d.Set("names", names)
+	return d.Set("saved_searches", savedSearches)
+}
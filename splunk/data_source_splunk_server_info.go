@@ -0,0 +1,89 @@
+package splunk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceSplunkServerInfo() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSplunkServerInfoRead,
+
+		Schema: map[string]*schema.Schema{
+			"server_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"build": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cluster_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"license_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Indexer clustering modes, by the server role that reveals them.
+var clusterModeRoles = map[string]string{
+	"cluster_master":      "master",
+	"cluster_manager":     "master",
+	"cluster_slave":       "slave",
+	"cluster_peer":        "slave",
+	"cluster_search_head": "searchhead",
+}
+
+func dataSourceSplunkServerInfoRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	o, err := c.GetEntry(PathServerInfo)
+	if err != nil {
+		return fmt.Errorf("Failed to read server info: %s", err)
+	}
+
+	roles := contentStringList(o.Content, "server_roles")
+	clusterMode := "disabled"
+	for _, role := range roles {
+		if mode, ok := clusterModeRoles[role]; ok {
+			clusterMode = mode
+			break
+		}
+	}
+
+	d.SetId(contentString(o.Content, "guid"))
+	d.Set("server_name", contentString(o.Content, "serverName"))
+	d.Set("guid", contentString(o.Content, "guid"))
+	d.Set("version", contentString(o.Content, "version"))
+	d.Set("build", contentString(o.Content, "build"))
+	d.Set("product_type", contentString(o.Content, "product_type"))
+	d.Set("server_roles", roles)
+	d.Set("cluster_mode", clusterMode)
+	d.Set("license_state", contentString(o.Content, "licenseState"))
+	return nil
+}
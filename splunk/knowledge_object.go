@@ -0,0 +1,320 @@
+package splunk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// namespacedPath builds a /servicesNS/{owner}/{app}/{path} endpoint. Owner "-"
+// and app "-" act as wildcards when reading.
+func namespacedPath(owner, app, path string) string {
+	return fmt.Sprintf(PathNamespaced, url.PathEscape(owner), url.PathEscape(app), path)
+}
+
+// knowledgeObjectID returns the import/state ID of a namespaced knowledge object.
+func knowledgeObjectID(app, name string) string {
+	return fmt.Sprintf("%s/%s", app, name)
+}
+
+// importKnowledgeObjectState imports an "app/name" ID in the nobody namespace,
+// the default owner, as the namespace isn't read back from Splunk.
+func importKnowledgeObjectState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseKnowledgeObjectID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	d.Set("owner", "nobody")
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseKnowledgeObjectID splits an "app/name" ID. The name may itself contain slashes.
+func parseKnowledgeObjectID(id string) (app, name string, e error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		e = fmt.Errorf("Invalid ID %q, expected app/name", id)
+		return
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// KnowledgeObjectCreate creates an entity under /servicesNS/{owner}/{app}/{path}.
+func (c *Client) KnowledgeObjectCreate(owner, app, path string, params url.Values) (r ACLEntry, e error) {
+	b, e := c.Post(namespacedPath(owner, app, path), params)
+	if e != nil {
+		return
+	}
+
+	return firstACLEntry(b, app)
+}
+
+// KnowledgeObjectRead returns the entity named name that lives in app.
+func (c *Client) KnowledgeObjectRead(owner, app, path, name string) (r ACLEntry, e error) {
+	b, e := c.Get(fmt.Sprintf("%s/%s", namespacedPath(owner, app, path), url.PathEscape(name)))
+	if e != nil {
+		return
+	}
+
+	return firstACLEntry(b, app)
+}
+
+// KnowledgeObjectUpdate posts params to the entity edit link.
+func (c *Client) KnowledgeObjectUpdate(owner, app, path, name string, params url.Values) (r ACLEntry, e error) {
+	link, e := c.KnowledgeObjectLink(owner, app, path, name, "edit")
+	if e != nil {
+		return
+	}
+
+	b, e := c.Post(link, params)
+	if e != nil {
+		return
+	}
+
+	return firstACLEntry(b, app)
+}
+
+// KnowledgeObjectDelete deletes an entity through its remove link.
+// ConfStanzaRead returns a stanza as merged in the app namespace, whether it
+// is defined in app or inherited from system or another app.
+func (c *Client) ConfStanzaRead(owner, app, conf, stanza string) (r ACLEntry, e error) {
+	b, e := c.Get(fmt.Sprintf("%s/%s", namespacedPath(owner, app, fmt.Sprintf(PathConf, conf)), url.PathEscape(stanza)))
+	if e != nil {
+		return
+	}
+
+	return firstACLEntry(b, "-")
+}
+
+// ConfStanzaUpdate posts params to a stanza in the app namespace, which writes
+// them to the local file of app and so also overrides inherited stanzas.
+func (c *Client) ConfStanzaUpdate(owner, app, conf, stanza string, params url.Values) (e error) {
+	_, e = c.Post(fmt.Sprintf("%s/%s", namespacedPath(owner, app, fmt.Sprintf(PathConf, conf)), url.PathEscape(stanza)), params)
+	return
+}
+
+func (c *Client) KnowledgeObjectDelete(owner, app, path, name string) (e error) {
+	link, e := c.KnowledgeObjectLink(owner, app, path, name, "remove")
+	if e != nil {
+		return
+	}
+
+	return c.Delete(link)
+}
+
+// KnowledgeObjectACLUpdate updates the sharing and permissions of an entity.
+func (c *Client) KnowledgeObjectACLUpdate(owner, app, path, name string, a *ACL) (e error) {
+	o, e := c.KnowledgeObjectRead(owner, app, path, name)
+	if e != nil {
+		return
+	}
+
+	link, ok := o.Links["edit"]
+	if !ok {
+		return errors.New("link not found")
+	}
+
+	// owner and sharing are mandatory on the acl endpoint
+	acl := *a
+	acl.App = ""
+	if acl.Owner == "" {
+		acl.Owner = o.ACL.Owner
+	}
+	if acl.Sharing == "" {
+		acl.Sharing = o.ACL.Sharing
+	}
+
+	_, e = c.ACLPost(&acl, fmt.Sprintf("%s/%s", link, "acl"))
+	return
+}
+
+func (c *Client) KnowledgeObjectLink(owner, app, path, name, linkType string) (link string, e error) {
+	o, e := c.KnowledgeObjectRead(owner, app, path, name)
+	if e != nil {
+		return
+	}
+
+	link, ok := o.Links[linkType]
+	if !ok {
+		e = errors.New("link not found")
+	}
+
+	return
+}
+
+// KnowledgeObjectsReassign transfers every knowledge object owned by from to
+// the user to, keeping their sharing and permissions.
+func (c *Client) KnowledgeObjectsReassign(from, to string) (e error) {
+	b, e := c.Get(namespacedPath(from, "-", PathDirectory) + "?count=0&search=" + url.QueryEscape("eai:acl.owner="+from))
+	if e != nil {
+		return
+	}
+
+	f := ACLFeed{}
+	e = json.Unmarshal(b, &f)
+	if e != nil {
+		return
+	}
+
+	for _, entry := range f.Entry {
+		if entry.ACL.Owner != from {
+			continue
+		}
+
+		link, err := knowledgeObjectACLLink(entry)
+		if err != nil {
+			return err
+		}
+
+		// only the writable fields, the listed ones also carry capabilities
+		acl := ACL{Owner: to, Sharing: entry.ACL.Sharing}
+		acl.Perms.Read = entry.ACL.Perms.Read
+		acl.Perms.Write = entry.ACL.Perms.Write
+		_, err = c.ACLPost(&acl, link)
+		if err != nil {
+			return fmt.Errorf("%s: %s", entry.Name, err)
+		}
+	}
+	return
+}
+
+// knowledgeObjectACLLink returns the acl endpoint of a directory entry, built
+// from the endpoint of its type, or else from its ID.
+func knowledgeObjectACLLink(entry ACLEntry) (string, error) {
+	if location := contentString(entry.Content, "eai:location"); location != "" {
+		path := namespacedPath(entry.ACL.Owner, entry.ACL.App, strings.TrimPrefix(location, "/"))
+		return fmt.Sprintf("%s/%s/acl", path, url.PathEscape(entry.Name)), nil
+	}
+
+	u, e := url.Parse(entry.ID)
+	if e != nil || u.Path == "" {
+		return "", fmt.Errorf("no endpoint found for %s", entry.Name)
+	}
+	return u.Path + "/acl", nil
+}
+
+// firstACLEntry decodes a feed and returns the entry that belongs to app, as
+// wildcard reads also return objects shared globally from other apps.
+func firstACLEntry(b []byte, app string) (r ACLEntry, e error) {
+	f := ACLFeed{}
+	e = json.Unmarshal(b, &f)
+	if e != nil {
+		return
+	}
+
+	acls := make([]ACL, len(f.Entry))
+	for i, entry := range f.Entry {
+		acls[i] = entry.ACL
+	}
+	i, e := appEntryIndex(acls, app)
+	if e != nil {
+		return
+	}
+	return f.Entry[i], nil
+}
+
+// appEntryIndex returns the index of the first entry that lives in app, any
+// entry matching the "-" wildcard. Entries shared from other apps don't count,
+// so an object missing from app reads as not found.
+func appEntryIndex(acls []ACL, app string) (int, error) {
+	for i, acl := range acls {
+		if app == "-" || acl.App == app {
+			return i, nil
+		}
+	}
+	return 0, errors.New("Unexpected response from Splunk: 404 no entry found")
+}
+
+// contentString reads a content attribute as a string, whatever its JSON type.
+func contentString(content map[string]interface{}, key string) string {
+	v, ok := content[key]
+	if !ok || v == nil {
+		return ""
+	}
+
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
+// contentBool reads a content attribute Splunk may return as a boolean, a number or a string.
+func contentBool(content map[string]interface{}, key string) bool {
+	switch t := content[key].(type) {
+	case bool:
+		return t
+	case float64:
+		return t != 0
+	case string:
+		b, _ := strconv.ParseBool(strings.ToLower(t))
+		return b || strings.EqualFold(t, "yes")
+	}
+	return false
+}
+
+// contentInt reads a content attribute Splunk may return as a number or a string.
+func contentInt(content map[string]interface{}, key string) int {
+	switch t := content[key].(type) {
+	case float64:
+		return int(t)
+	case string:
+		i, _ := strconv.Atoi(t)
+		return i
+	}
+	return 0
+}
+
+// contentStringList reads a content attribute returned either as a list or a comma delimited string.
+func contentStringList(content map[string]interface{}, key string) (s []string) {
+	switch t := content[key].(type) {
+	case []interface{}:
+		for _, v := range t {
+			s = append(s, fmt.Sprint(v))
+		}
+	case string:
+		for _, v := range strings.Split(t, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				s = append(s, v)
+			}
+		}
+	}
+	return
+}
+
+// contentPrefixMap collects the non empty content attributes starting with
+// prefix, keyed by the remainder of their name.
+func contentPrefixMap(content map[string]interface{}, prefix string) map[string]string {
+	m := make(map[string]string)
+	for k := range content {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		if v := contentString(content, k); v != "" {
+			m[strings.TrimPrefix(k, prefix)] = v
+		}
+	}
+	return m
+}
+
+// setPrefixMapParams posts every entry of the map attribute key as
+// prefix+name, and blanks out entries removed since the last apply.
+func setPrefixMapParams(d *schema.ResourceData, r url.Values, key, prefix string) {
+	o, n := d.GetChange(key)
+	for k := range o.(map[string]interface{}) {
+		r.Set(prefix+k, "")
+	}
+	for k, v := range n.(map[string]interface{}) {
+		r.Set(prefix+k, v.(string))
+	}
+}
@@ -0,0 +1,46 @@
+package splunk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// kvStoreBatchSize is the default max_documents_per_batch_save of limits.conf.
+const kvStoreBatchSize = 1000
+
+func kvStoreDataPath(owner, app, collection string) string {
+	return namespacedPath(owner, app, fmt.Sprintf(PathKVStoreData, url.PathEscape(collection)))
+}
+
+// KVStoreRecordsRead returns every record of a collection.
+func (c *Client) KVStoreRecordsRead(owner, app, collection string) (r []map[string]interface{}, e error) {
+	b, e := c.Get(kvStoreDataPath(owner, app, collection))
+	if e != nil {
+		return
+	}
+
+	e = json.Unmarshal(b, &r)
+	return
+}
+
+// KVStoreRecordsBatchSave inserts or replaces records by _key, in batches.
+func (c *Client) KVStoreRecordsBatchSave(owner, app, collection string, records []map[string]interface{}) (e error) {
+	for start := 0; start < len(records); start += kvStoreBatchSize {
+		end := start + kvStoreBatchSize
+		if end > len(records) {
+			end = len(records)
+		}
+
+		_, e = c.PostJSON(fmt.Sprintf("%s/batch_save", kvStoreDataPath(owner, app, collection)), records[start:end])
+		if e != nil {
+			return
+		}
+	}
+	return
+}
+
+// KVStoreRecordDelete removes the record identified by key.
+func (c *Client) KVStoreRecordDelete(owner, app, collection, key string) (e error) {
+	return c.Delete(fmt.Sprintf("%s/%s", kvStoreDataPath(owner, app, collection), url.PathEscape(key)))
+}
@@ -0,0 +1,67 @@
+package splunk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// LookupTableFileContentsRead returns the rows of a CSV lookup, header included.
+// Contents go through the Lookup Editor app endpoint as the core REST API can
+// only register files already staged on the search head.
+func (c *Client) LookupTableFileContentsRead(owner, app, name string) (rows [][]string, e error) {
+	params := url.Values{}
+	params.Set("lookup_file", name)
+	params.Set("namespace", app)
+	params.Set("owner", owner)
+	params.Set("lookup_type", "csv")
+
+	b, e := c.Get(fmt.Sprintf("%s?%s", PathLookupContents, params.Encode()))
+	if e != nil {
+		return
+	}
+
+	e = json.Unmarshal(b, &rows)
+	return
+}
+
+// LookupTableFileContentsWrite creates or replaces a CSV lookup with rows.
+func (c *Client) LookupTableFileContentsWrite(owner, app, name string, rows [][]string) (e error) {
+	contents, e := json.Marshal(rows)
+	if e != nil {
+		return
+	}
+
+	params := url.Values{}
+	params.Set("lookup_file", name)
+	params.Set("namespace", app)
+	params.Set("owner", owner)
+	params.Set("lookup_type", "csv")
+	params.Set("contents", string(contents))
+
+	_, e = c.Post(PathLookupContents, params)
+	return
+}
+
+// parseLookupCSV parses CSV content into rows.
+func parseLookupCSV(content string) (rows [][]string, e error) {
+	r := csv.NewReader(strings.NewReader(content))
+	r.FieldsPerRecord = -1
+	return r.ReadAll()
+}
+
+// lookupChecksum returns the SHA-256 of rows once written back as CSV, so
+// that quoting and line ending differences don't register as changes.
+func lookupChecksum(rows [][]string) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if e := w.WriteAll(rows); e != nil {
+		return "", e
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(buf.Bytes())), nil
+}
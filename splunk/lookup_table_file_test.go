@@ -0,0 +1,66 @@
+package splunk
+
+import (
+	"testing"
+)
+
+func TestLookupChecksum(t *testing.T) {
+	base := "host,owner\nweb01,ops\ndb01,dba\n"
+
+	cases := []struct {
+		Name    string
+		Content string
+		Same    bool
+	}{
+		{
+			Name:    "identical",
+			Content: base,
+			Same:    true,
+		},
+		{
+			Name:    "CRLF line endings",
+			Content: "host,owner\r\nweb01,ops\r\ndb01,dba\r\n",
+			Same:    true,
+		},
+		{
+			Name:    "quoted fields",
+			Content: "\"host\",\"owner\"\n\"web01\",\"ops\"\n\"db01\",\"dba\"\n",
+			Same:    true,
+		},
+		{
+			Name:    "no trailing newline",
+			Content: "host,owner\nweb01,ops\ndb01,dba",
+			Same:    true,
+		},
+		{
+			Name:    "changed value",
+			Content: "host,owner\nweb01,ops\ndb01,ops\n",
+			Same:    false,
+		},
+		{
+			Name:    "reordered rows",
+			Content: "host,owner\ndb01,dba\nweb01,ops\n",
+			Same:    false,
+		},
+	}
+
+	expected := mustLookupChecksum(t, base)
+	for _, tc := range cases {
+		if got := mustLookupChecksum(t, tc.Content); (got == expected) != tc.Same {
+			t.Fatalf("%s: expected same checksum to be %t, got %s and %s", tc.Name, tc.Same, expected, got)
+		}
+	}
+}
+
+func mustLookupChecksum(t *testing.T, content string) string {
+	rows, err := parseLookupCSV(content)
+	if err != nil {
+		t.Fatalf("%q: err: %s", content, err)
+	}
+
+	checksum, err := lookupChecksum(rows)
+	if err != nil {
+		t.Fatalf("%q: err: %s", content, err)
+	}
+	return checksum
+}
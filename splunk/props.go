@@ -0,0 +1,29 @@
+package splunk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// propsEntityName returns the name Splunk gives to a props.conf class, e.g.
+// "access_combined : EXTRACT-status" for an inline extraction named status.
+func propsEntityName(stanza, class, name string) string {
+	return fmt.Sprintf("%s : %s-%s", stanza, class, name)
+}
+
+// parsePropsEntityName splits a props.conf entity name into its stanza, class and name.
+func parsePropsEntityName(entity string) (stanza, class, name string, e error) {
+	parts := strings.SplitN(entity, " : ", 2)
+	if len(parts) != 2 {
+		e = fmt.Errorf("Invalid props entity %q, expected \"stanza : CLASS-name\"", entity)
+		return
+	}
+
+	attr := strings.SplitN(parts[1], "-", 2)
+	if len(attr) != 2 {
+		e = fmt.Errorf("Invalid props entity %q, expected \"stanza : CLASS-name\"", entity)
+		return
+	}
+
+	return parts[0], attr[0], attr[1], nil
+}
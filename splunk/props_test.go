@@ -0,0 +1,61 @@
+package splunk
+
+import (
+	"testing"
+)
+
+func TestParsePropsEntityName(t *testing.T) {
+	cases := []struct {
+		Entity string
+		Stanza string
+		Class  string
+		Name   string
+		Error  bool
+	}{
+		{
+			Entity: "access_combined : EXTRACT-status",
+			Stanza: "access_combined",
+			Class:  "EXTRACT",
+			Name:   "status",
+		},
+		{
+			Entity: "source::/var/log/*.log : FIELDALIAS-src-ip",
+			Stanza: "source::/var/log/*.log",
+			Class:  "FIELDALIAS",
+			Name:   "src-ip",
+		},
+		{
+			Entity: "access_combined : EVAL-a : b",
+			Stanza: "access_combined",
+			Class:  "EVAL",
+			Name:   "a : b",
+		},
+		{
+			Entity: "access_combined:EXTRACT-status",
+			Error:  true,
+		},
+		{
+			Entity: "access_combined : EXTRACT",
+			Error:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		stanza, class, name, err := parsePropsEntityName(tc.Entity)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("%q: expected an error", tc.Entity)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%q: err: %s", tc.Entity, err)
+		}
+		if stanza != tc.Stanza || class != tc.Class || name != tc.Name {
+			t.Fatalf("%q: expected %q %q %q, got %q %q %q", tc.Entity, tc.Stanza, tc.Class, tc.Name, stanza, class, name)
+		}
+		if entity := propsEntityName(stanza, class, name); entity != tc.Entity {
+			t.Fatalf("%q: round trip gave %q", tc.Entity, entity)
+		}
+	}
+}
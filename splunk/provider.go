@@ -44,6 +44,36 @@ func Provider() terraform.ResourceProvider {
             "splunk_saved_search": resourceSplunkSavedSearch(),
             "splunk_user": resourceSplunkUser(),
             "splunk_role": resourceSplunkRole(),
+            "splunk_lookup_table_file": resourceSplunkLookupTableFile(),
+            "splunk_lookup_definition": resourceSplunkLookupDefinition(),
+            "splunk_kvstore_collection": resourceSplunkKVStoreCollection(),
+            "splunk_kvstore_records": resourceSplunkKVStoreRecords(),
+            "splunk_macro": resourceSplunkMacro(),
+            "splunk_eventtype": resourceSplunkEventType(),
+            "splunk_tag": resourceSplunkTag(),
+            "splunk_field_extraction": resourceSplunkFieldExtraction(),
+            "splunk_field_alias": resourceSplunkFieldAlias(),
+            "splunk_calculated_field": resourceSplunkCalculatedField(),
+            "splunk_transform_extraction": resourceSplunkTransformExtraction(),
+            "splunk_sourcetype": resourceSplunkSourcetype(),
+            "splunk_dashboard": resourceSplunkDashboard(),
+            "splunk_app_nav": resourceSplunkAppNav(),
+            "splunk_conf_stanza": resourceSplunkConfStanza(),
+            "splunk_datamodel": resourceSplunkDataModel(),
+            "splunk_workflow_action": resourceSplunkWorkflowAction(),
+            "splunk_password": resourceSplunkPassword(),
+            "splunk_authentication_token": resourceSplunkAuthenticationToken(),
+            "splunk_authentication_ldap": resourceSplunkAuthenticationLDAP(),
+            "splunk_ldap_group_role_mapping": resourceSplunkLDAPGroupRoleMapping(),
+            "splunk_authentication_saml": resourceSplunkAuthenticationSAML(),
+            "splunk_saml_group": resourceSplunkSAMLGroup(),
+        },
+
+        DataSourcesMap: map[string]*schema.Resource{
+            "splunk_current_user": dataSourceSplunkCurrentUser(),
+            "splunk_server_info": dataSourceSplunkServerInfo(),
+            "splunk_saved_search": dataSourceSplunkSavedSearch(),
+            "splunk_saved_searches": dataSourceSplunkSavedSearches(),
         },
 
         ConfigureFunc: providerConfigure,
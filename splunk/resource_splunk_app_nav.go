@@ -0,0 +1,360 @@
+package splunk
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// appNav is the navigation menu XML of an app.
+type appNav struct {
+	XMLName    xml.Name     `xml:"nav"`
+	SearchView string       `xml:"search_view,attr,omitempty"`
+	Color      string       `xml:"color,attr,omitempty"`
+	Items      []appNavItem `xml:",any"`
+}
+
+// appNavItem is a top level view or collection, kept in a single list as
+// their order is the one of the app bar.
+type appNavItem struct {
+	XMLName xml.Name
+	Name    string       `xml:"name,attr,omitempty"`
+	Default bool         `xml:"default,attr,omitempty"`
+	Label   string       `xml:"label,attr,omitempty"`
+	Views   []appNavView `xml:"view"`
+}
+
+type appNavView struct {
+	Name string `xml:"name,attr"`
+}
+
+func resourceSplunkAppNav() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkAppNavCreate,
+		Read:   resourceSplunkAppNavRead,
+		Update: resourceSplunkAppNavUpdate,
+		Delete: resourceSplunkAppNavDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSplunkAppNavImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"eai_data": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"nav"},
+				DiffSuppressFunc: suppressEquivalentXMLDiff,
+			},
+			"nav": {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ConflictsWith: []string{"eai_data"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"search_view": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"default_view": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"item": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"view": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"collection": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"views": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"acl": aclSchema(),
+			"original_eai_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSplunkAppNavCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+
+	r, err := appNavParams(d)
+	if err != nil {
+		return err
+	}
+
+	// Apps usually ship a default nav already, which is then overridden and
+	// restored on destroy.
+	log.Printf("[DEBUG] Splunk App Nav create: %s", app)
+	o, err := c.KnowledgeObjectRead("-", app, PathNav, "default")
+	if err != nil && strings.Contains(err.Error(), "404") {
+		r.Set("name", "default")
+		_, err = c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathNav, r)
+	} else if err == nil {
+		d.Set("original_eai_data", contentString(o.Content, "eai:data"))
+		_, err = c.KnowledgeObjectUpdate("-", app, PathNav, "default", r)
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to create app nav: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, "default"))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathNav, "default", acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update app nav ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkAppNavRead(d, meta)
+}
+
+func resourceSplunkAppNavRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathNav, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("app", app)
+
+	data := contentString(o.Content, "eai:data")
+	if len(d.Get("nav").([]interface{})) > 0 {
+		nav := appNav{}
+		err = xml.Unmarshal([]byte(data), &nav)
+		if err != nil {
+			return fmt.Errorf("Failed to parse app nav: %s", err)
+		}
+		d.Set("nav", flattenAppNav(&nav))
+	} else {
+		d.Set("eai_data", data)
+	}
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkAppNavUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	r, err := appNavParams(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk App Nav update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathNav, name, r)
+	if err != nil {
+		return fmt.Errorf("Failed to update app nav: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathNav, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update app nav ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkAppNavRead(d, meta)
+}
+
+// resourceSplunkAppNavDelete restores the nav an app had before it was
+// managed, and only deletes the navs created by Terraform.
+func resourceSplunkAppNavDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if original := d.Get("original_eai_data").(string); original != "" {
+		r := url.Values{}
+		r.Set("eai:data", original)
+
+		log.Printf("[INFO] Restoring original Splunk App Nav: %s", d.Id())
+		_, err = c.KnowledgeObjectUpdate("-", app, PathNav, name, r)
+		if err != nil && !strings.Contains(err.Error(), "404") {
+			return fmt.Errorf("Error restoring Splunk App Nav: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting Splunk App Nav: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathNav, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk App Nav: %s", err)
+	}
+	return nil
+}
+
+// resourceSplunkAppNavImport keeps the current nav of the app, restored when
+// the resource is destroyed, in the default nobody namespace.
+func resourceSplunkAppNavImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathNav, name)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("owner", "nobody")
+	d.Set("original_eai_data", contentString(o.Content, "eai:data"))
+	return []*schema.ResourceData{d}, nil
+}
+
+func appNavParams(d *schema.ResourceData) (url.Values, error) {
+	r := url.Values{}
+
+	n := d.Get("nav").([]interface{})
+	if len(n) == 0 || n[0] == nil {
+		if d.Get("eai_data").(string) == "" {
+			return nil, fmt.Errorf("One of eai_data or nav must be set")
+		}
+		r.Set("eai:data", d.Get("eai_data").(string))
+		return r, nil
+	}
+
+	nav, err := expandAppNav(n[0].(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := xml.MarshalIndent(nav, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	r.Set("eai:data", string(b))
+	return r, nil
+}
+
+func expandAppNav(m map[string]interface{}) (*appNav, error) {
+	nav := &appNav{
+		SearchView: m["search_view"].(string),
+		Color:      m["color"].(string),
+	}
+
+	defaultView := m["default_view"].(string)
+	for _, i := range m["item"].([]interface{}) {
+		im := i.(map[string]interface{})
+		view, label := im["view"].(string), im["collection"].(string)
+		views := stringArrayFromInterface(im["views"].([]interface{}))
+
+		switch {
+		case view != "" && label == "" && len(views) == 0:
+			nav.Items = append(nav.Items, appNavItem{
+				XMLName: xml.Name{Local: "view"},
+				Name:    view,
+				Default: view == defaultView,
+			})
+		case view == "" && label != "":
+			collection := appNavItem{XMLName: xml.Name{Local: "collection"}, Label: label}
+			for _, v := range views {
+				collection.Views = append(collection.Views, appNavView{Name: v})
+			}
+			nav.Items = append(nav.Items, collection)
+		default:
+			return nil, fmt.Errorf("Each nav item must set either view, or collection and its views")
+		}
+	}
+	return nav, nil
+}
+
+// flattenAppNav only keeps the views and collections, other elements such as
+// links and dividers aren't supported by the nav block.
+func flattenAppNav(nav *appNav) []interface{} {
+	m := make(map[string]interface{})
+
+	m["search_view"] = nav.SearchView
+	m["color"] = nav.Color
+	m["default_view"] = ""
+
+	var items []interface{}
+	for _, i := range nav.Items {
+		switch i.XMLName.Local {
+		case "view":
+			if i.Default {
+				m["default_view"] = i.Name
+			}
+			items = append(items, map[string]interface{}{
+				"view":       i.Name,
+				"collection": "",
+				"views":      []string{},
+			})
+		case "collection":
+			var views []string
+			for _, v := range i.Views {
+				views = append(views, v.Name)
+			}
+			items = append(items, map[string]interface{}{
+				"view":       "",
+				"collection": i.Label,
+				"views":      views,
+			})
+		}
+	}
+	m["item"] = items
+	return []interface{}{m}
+}
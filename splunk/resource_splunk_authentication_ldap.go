@@ -0,0 +1,289 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceSplunkAuthenticationLDAP() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkAuthenticationLDAPCreate,
+		Read:   resourceSplunkAuthenticationLDAPRead,
+		Update: resourceSplunkAuthenticationLDAPUpdate,
+		Delete: resourceSplunkAuthenticationLDAPDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      389,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"ssl_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"bind_dn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"bind_dn_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				StateFunc: hashSecret,
+			},
+			"user_base_dn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_base_filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user_name_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "uid",
+			},
+			"real_name_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "cn",
+			},
+			"email_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "mail",
+			},
+			"group_base_dn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_base_filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"group_name_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "cn",
+			},
+			"group_member_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "member",
+			},
+			"group_mapping_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"nested_groups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"anonymous_referrals": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"size_limit": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1000,
+			},
+			"time_limit": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  15,
+			},
+			"network_timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  20,
+			},
+			"order": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+// LDAP strategy keys behind the string arguments.
+var ldapStringKeys = map[string]string{
+	"host":                    "host",
+	"bind_dn":                 "bindDN",
+	"user_base_dn":            "userBaseDN",
+	"user_base_filter":        "userBaseFilter",
+	"user_name_attribute":     "userNameAttribute",
+	"real_name_attribute":     "realNameAttribute",
+	"email_attribute":         "emailAttribute",
+	"group_base_dn":           "groupBaseDN",
+	"group_base_filter":       "groupBaseFilter",
+	"group_name_attribute":    "groupNameAttribute",
+	"group_member_attribute":  "groupMemberAttribute",
+	"group_mapping_attribute": "groupMappingAttribute",
+}
+
+// LDAP strategy keys behind the int arguments.
+var ldapIntKeys = map[string]string{
+	"port":            "port",
+	"size_limit":      "sizelimit",
+	"time_limit":      "timelimit",
+	"network_timeout": "network_timeout",
+}
+
+// LDAP strategy keys behind the bool arguments.
+var ldapBoolKeys = map[string]string{
+	"ssl_enabled":         "SSLEnabled",
+	"nested_groups":       "nestedGroups",
+	"anonymous_referrals": "anonymous_referrals",
+}
+
+func resourceSplunkAuthenticationLDAPCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	name := d.Get("name").(string)
+
+	r := ldapParams(d)
+	r.Set("name", name)
+	r.Set("bindDNpassword", d.Get("bind_dn_password").(string))
+
+	log.Printf("[DEBUG] Splunk LDAP Strategy create: %s", name)
+	_, err := c.Post(PathLDAPStrategies, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create LDAP strategy: %s", err)
+	}
+
+	d.SetId(name)
+
+	err = c.AuthSettingsMove(name, d.Get("order").(int))
+	if err != nil {
+		return fmt.Errorf("Failed to enable LDAP strategy: %s", err)
+	}
+
+	return resourceSplunkAuthenticationLDAPRead(d, meta)
+}
+
+func resourceSplunkAuthenticationLDAPRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	entry, err := c.GetEntry(fmt.Sprintf(PathLDAPStrategy, url.PathEscape(d.Id())))
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	content := entry.Content
+
+	d.Set("name", d.Id())
+	for k, key := range ldapStringKeys {
+		d.Set(k, contentString(content, key))
+	}
+	for k, key := range ldapIntKeys {
+		d.Set(k, contentInt(content, key))
+	}
+	for k, key := range ldapBoolKeys {
+		d.Set(k, contentBool(content, key))
+	}
+
+	authType, strategies, err := c.AuthSettingsRead()
+	if err != nil {
+		return err
+	}
+	// the LDAP strategies aren't listed while SAML is in use
+	if authType == "SAML" {
+		return nil
+	}
+	d.Set("order", 0)
+	for i, s := range strategies {
+		if s == d.Id() {
+			d.Set("order", i+1)
+		}
+	}
+
+	return nil
+}
+
+func resourceSplunkAuthenticationLDAPUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	r := ldapParams(d)
+	if d.HasChange("bind_dn_password") {
+		r.Set("bindDNpassword", d.Get("bind_dn_password").(string))
+	}
+
+	log.Printf("[DEBUG] Splunk LDAP Strategy update: %s", d.Id())
+	_, err := c.Post(fmt.Sprintf(PathLDAPStrategy, url.PathEscape(d.Id())), r)
+	if err != nil {
+		return fmt.Errorf("Failed to update LDAP strategy: %s", err)
+	}
+
+	if v, ok := d.GetOk("order"); ok && d.HasChange("order") {
+		err = c.AuthSettingsMove(d.Id(), v.(int))
+		if err != nil {
+			return fmt.Errorf("Failed to order LDAP strategy: %s", err)
+		}
+	}
+
+	return resourceSplunkAuthenticationLDAPRead(d, meta)
+}
+
+// resourceSplunkAuthenticationLDAPDelete removes the strategy from the ones
+// tried first, falling back to native authentication when it was the last.
+func resourceSplunkAuthenticationLDAPDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	err := c.AuthSettingsRemove("LDAP", d.Id())
+	if err != nil {
+		return fmt.Errorf("Failed to disable LDAP strategy: %s", err)
+	}
+
+	log.Printf("[INFO] Deleting Splunk LDAP Strategy: %s", d.Id())
+	err = c.Delete(fmt.Sprintf(PathLDAPStrategy, url.PathEscape(d.Id())))
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk LDAP Strategy: %s", err)
+	}
+	return nil
+}
+
+func ldapParams(d *schema.ResourceData) url.Values {
+	r := url.Values{}
+	for k, key := range ldapStringKeys {
+		r.Set(key, d.Get(k).(string))
+	}
+	for k, key := range ldapIntKeys {
+		r.Set(key, strconv.Itoa(d.Get(k).(int)))
+	}
+	for k, key := range ldapBoolKeys {
+		r.Set(key, strconv.FormatBool(d.Get(k).(bool)))
+	}
+	return r
+}
@@ -0,0 +1,292 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+var samlSignatureAlgorithms = []string{"RSA-SHA1", "RSA-SHA256", "RSA-SHA384", "RSA-SHA512"}
+
+func resourceSplunkAuthenticationSAML() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkAuthenticationSAMLCreate,
+		Read:   resourceSplunkAuthenticationSAMLRead,
+		Update: resourceSplunkAuthenticationSAMLUpdate,
+		Delete: resourceSplunkAuthenticationSAMLDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"entity_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"idp_sso_url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"idp_slo_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"idp_certificate": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"issuer_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fqdn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect_port": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"name_id_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_role_if_missing": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sign_authn_request": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"signed_assertion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"signature_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(samlSignatureAlgorithms, false),
+			},
+			"previous_auth_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"previous_auth_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"inbound_signature_algorithms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(samlSignatureAlgorithms, false),
+				},
+			},
+			"attribute_query_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"attribute_query_username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"attribute_query_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				StateFunc: hashSecret,
+			},
+			"attribute_query_request_signed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"attribute_query_response_signed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// SAML strategy keys behind the string arguments.
+var samlStringKeys = map[string]string{
+	"entity_id":                "entityId",
+	"idp_sso_url":              "idpSSOUrl",
+	"idp_slo_url":              "idpSLOUrl",
+	"issuer_id":                "issuerId",
+	"fqdn":                     "fqdn",
+	"name_id_format":           "nameIdFormat",
+	"default_role_if_missing":  "defaultRoleIfMissing",
+	"attribute_query_url":      "idpAttributeQueryUrl",
+	"attribute_query_username": "attributeQuerySoapUsername",
+}
+
+// SAML strategy keys behind the bool arguments.
+var samlBoolKeys = map[string]string{
+	"sign_authn_request":              "signAuthnRequest",
+	"signed_assertion":                "signedAssertion",
+	"attribute_query_request_signed":  "attributeQueryRequestSigned",
+	"attribute_query_response_signed": "attributeQueryResponseSigned",
+}
+
+func resourceSplunkAuthenticationSAMLCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	name := d.Get("name").(string)
+
+	r := samlParams(d)
+	r.Set("name", name)
+	if v, ok := d.GetOk("idp_certificate"); ok {
+		r.Set("idpCertificatePayload", v.(string))
+	}
+	if v, ok := d.GetOk("attribute_query_password"); ok {
+		r.Set("attributeQuerySoapPassword", v.(string))
+	}
+
+	log.Printf("[DEBUG] Splunk SAML Strategy create: %s", name)
+	_, err := c.Post(PathSAMLStrategies, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create SAML strategy: %s", err)
+	}
+
+	d.SetId(name)
+
+	// Splunk only supports a single SAML strategy, which takes over whatever
+	// authentication was in use. The previous settings are restored on delete.
+	previousType, previous, err := c.AuthSettingsRead()
+	if err != nil {
+		return fmt.Errorf("Failed to read authentication settings: %s", err)
+	}
+	d.Set("previous_auth_type", previousType)
+	d.Set("previous_auth_settings", previous)
+
+	err = c.AuthSettingsUpdate("SAML", []string{name})
+	if err != nil {
+		return fmt.Errorf("Failed to enable SAML strategy: %s", err)
+	}
+
+	return resourceSplunkAuthenticationSAMLRead(d, meta)
+}
+
+func resourceSplunkAuthenticationSAMLRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	entry, err := c.GetEntry(fmt.Sprintf(PathSAMLStrategy, url.PathEscape(d.Id())))
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	content := entry.Content
+
+	// The certificate is stored as a file and isn't returned.
+	d.Set("name", d.Id())
+	for k, key := range samlStringKeys {
+		d.Set(k, contentString(content, key))
+	}
+	for k, key := range samlBoolKeys {
+		d.Set(k, contentBool(content, key))
+	}
+	d.Set("redirect_port", contentInt(content, "redirectPort"))
+	d.Set("signature_algorithm", contentString(content, "signatureAlgorithm"))
+
+	var inbound []string
+	for _, v := range strings.Split(contentString(content, "inboundSignatureAlgorithm"), ";") {
+		if v = strings.TrimSpace(v); v != "" {
+			inbound = append(inbound, v)
+		}
+	}
+	d.Set("inbound_signature_algorithms", inbound)
+
+	return nil
+}
+
+func resourceSplunkAuthenticationSAMLUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	r := samlParams(d)
+	if d.HasChange("idp_certificate") {
+		r.Set("idpCertificatePayload", d.Get("idp_certificate").(string))
+	}
+	if d.HasChange("attribute_query_password") {
+		r.Set("attributeQuerySoapPassword", d.Get("attribute_query_password").(string))
+	}
+
+	log.Printf("[DEBUG] Splunk SAML Strategy update: %s", d.Id())
+	_, err := c.Post(fmt.Sprintf(PathSAMLStrategy, url.PathEscape(d.Id())), r)
+	if err != nil {
+		return fmt.Errorf("Failed to update SAML strategy: %s", err)
+	}
+
+	return resourceSplunkAuthenticationSAMLRead(d, meta)
+}
+
+// resourceSplunkAuthenticationSAMLDelete restores the authentication used
+// before, or falls back to LDAP or native authentication for imported
+// strategies, before removing the strategy so that users can still log in.
+func resourceSplunkAuthenticationSAMLDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	var err error
+	if previousType := d.Get("previous_auth_type").(string); previousType != "" {
+		previous := stringArrayFromInterface(d.Get("previous_auth_settings").([]interface{}))
+		err = c.AuthSettingsRestore(d.Id(), previousType, previous)
+	} else {
+		err = c.AuthSettingsRemove("SAML", d.Id())
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to disable SAML strategy: %s", err)
+	}
+
+	log.Printf("[INFO] Deleting Splunk SAML Strategy: %s", d.Id())
+	err = c.Delete(fmt.Sprintf(PathSAMLStrategy, url.PathEscape(d.Id())))
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk SAML Strategy: %s", err)
+	}
+	return nil
+}
+
+func samlParams(d *schema.ResourceData) url.Values {
+	r := url.Values{}
+	for k, key := range samlStringKeys {
+		r.Set(key, d.Get(k).(string))
+	}
+	for k, key := range samlBoolKeys {
+		r.Set(key, strconv.FormatBool(d.Get(k).(bool)))
+	}
+	if v, ok := d.GetOk("redirect_port"); ok {
+		r.Set("redirectPort", strconv.Itoa(v.(int)))
+	}
+	if v, ok := d.GetOk("signature_algorithm"); ok {
+		r.Set("signatureAlgorithm", v.(string))
+	}
+	if v, ok := d.GetOk("inbound_signature_algorithms"); ok {
+		r.Set("inboundSignatureAlgorithm", strings.Join(stringArrayFromInterface(v.([]interface{})), ";"))
+	}
+	return r
+}
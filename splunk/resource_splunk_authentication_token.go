@@ -0,0 +1,180 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkAuthenticationToken() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceSplunkAuthenticationTokenCreate,
+		Read:          resourceSplunkAuthenticationTokenRead,
+		Update:        resourceSplunkAuthenticationTokenUpdate,
+		Delete:        resourceSplunkAuthenticationTokenDelete,
+		CustomizeDiff: resourceSplunkAuthenticationTokenCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"user": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"audience": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"expires_on": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"not_before": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"renew_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDuration,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expiration": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSplunkAuthenticationTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	r := url.Values{}
+	r.Set("name", d.Get("user").(string))
+	r.Set("audience", d.Get("audience").(string))
+	if v, ok := d.GetOk("expires_on"); ok {
+		r.Set("expires_on", v.(string))
+	}
+	if v, ok := d.GetOk("not_before"); ok {
+		r.Set("not_before", v.(string))
+	}
+
+	log.Printf("[DEBUG] Splunk Authentication Token create: %s", d.Get("user").(string))
+	id, token, err := c.TokenCreate(r)
+	if err != nil {
+		return fmt.Errorf("Failed to create authentication token: %s", err)
+	}
+
+	d.SetId(id)
+	d.Set("token", token)
+
+	if !d.Get("enabled").(bool) {
+		err = c.TokenStatusUpdate(d.Get("user").(string), id, "disabled")
+		if err != nil {
+			return fmt.Errorf("Failed to disable authentication token: %s", err)
+		}
+	}
+
+	return resourceSplunkAuthenticationTokenRead(d, meta)
+}
+
+func resourceSplunkAuthenticationTokenRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	o, err := c.TokenRead(d.Get("user").(string), d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	if claims, ok := o.Content["claims"].(map[string]interface{}); ok {
+		d.Set("audience", contentString(claims, "aud"))
+		d.Set("expiration", contentInt(claims, "exp"))
+	}
+	d.Set("enabled", contentString(o.Content, "status") != "disabled")
+
+	return nil
+}
+
+func resourceSplunkAuthenticationTokenUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	if d.HasChange("enabled") {
+		status := "disabled"
+		if d.Get("enabled").(bool) {
+			status = "enabled"
+		}
+
+		log.Printf("[DEBUG] Splunk Authentication Token update: %s", d.Id())
+		err := c.TokenStatusUpdate(d.Get("user").(string), d.Id(), status)
+		if err != nil {
+			return fmt.Errorf("Failed to update authentication token: %s", err)
+		}
+	}
+
+	return resourceSplunkAuthenticationTokenRead(d, meta)
+}
+
+func resourceSplunkAuthenticationTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	log.Printf("[INFO] Deleting Splunk Authentication Token: %s", d.Id())
+	err := c.TokenDelete(d.Get("user").(string), d.Id())
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Authentication Token: %s", err)
+	}
+	return nil
+}
+
+// resourceSplunkAuthenticationTokenCustomizeDiff replaces the token once it
+// expires within renew_before.
+func resourceSplunkAuthenticationTokenCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	renewBefore, ok := d.GetOk("renew_before")
+	expiration := d.Get("expiration").(int)
+	if !ok || expiration == 0 {
+		return nil
+	}
+
+	// already validated
+	window, _ := time.ParseDuration(renewBefore.(string))
+	if time.Now().Add(window).Before(time.Unix(int64(expiration), 0)) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Splunk Authentication Token %s expires on %d, renewing", d.Id(), expiration)
+	if err := d.SetNewComputed("token"); err != nil {
+		return err
+	}
+	return d.ForceNew("token")
+}
+
+func validateDuration(v interface{}, k string) (ws []string, es []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%q must be a duration such as \"720h\": %s", k, err))
+	}
+	return
+}
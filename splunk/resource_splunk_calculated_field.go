@@ -0,0 +1,157 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkCalculatedField() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkCalculatedFieldCreate,
+		Read:   resourceSplunkCalculatedFieldRead,
+		Update: resourceSplunkCalculatedFieldUpdate,
+		Delete: resourceSplunkCalculatedFieldDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stanza": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkCalculatedFieldCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	stanza := d.Get("stanza").(string)
+	name := d.Get("name").(string)
+
+	r := url.Values{}
+	r.Set("name", name)
+	r.Set("stanza", stanza)
+	r.Set("value", d.Get("value").(string))
+
+	log.Printf("[DEBUG] Splunk Calculated Field create: %s/%s", app, name)
+	_, err := c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathPropsCalcFields, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create calculated field: %s", err)
+	}
+
+	entity := propsEntityName(stanza, "EVAL", name)
+	d.SetId(knowledgeObjectID(app, entity))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathPropsCalcFields, entity, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update calculated field ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkCalculatedFieldRead(d, meta)
+}
+
+func resourceSplunkCalculatedFieldRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, entity, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	stanza, _, name, err := parsePropsEntityName(entity)
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathPropsCalcFields, entity)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("stanza", stanza)
+	d.Set("app", app)
+	d.Set("value", contentString(o.Content, "value"))
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkCalculatedFieldUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, entity, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	r := url.Values{}
+	r.Set("value", d.Get("value").(string))
+
+	log.Printf("[DEBUG] Splunk Calculated Field update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathPropsCalcFields, entity, r)
+	if err != nil {
+		return fmt.Errorf("Failed to update calculated field: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathPropsCalcFields, entity, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update calculated field ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkCalculatedFieldRead(d, meta)
+}
+
+func resourceSplunkCalculatedFieldDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, entity, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Calculated Field: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathPropsCalcFields, entity)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Calculated Field: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkConfStanza() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkConfStanzaCreate,
+		Read:   resourceSplunkConfStanzaRead,
+		Update: resourceSplunkConfStanzaUpdate,
+		Delete: resourceSplunkConfStanzaDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSplunkConfStanzaImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"conf": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stanza": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"acl": aclSchema(),
+			"adopted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSplunkConfStanzaCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	conf := d.Get("conf").(string)
+	stanza := d.Get("stanza").(string)
+	path := fmt.Sprintf(PathConf, conf)
+
+	r := url.Values{}
+	setPrefixMapParams(d, r, "variables", "")
+
+	// The stanza may already exist, e.g. when only overriding a default.
+	log.Printf("[DEBUG] Splunk Conf Stanza create: %s/%s/%s", app, conf, stanza)
+	_, err := c.ConfStanzaRead(d.Get("owner").(string), app, conf, stanza)
+	if err != nil && strings.Contains(err.Error(), "404") {
+		r.Set("name", stanza)
+		_, err = c.KnowledgeObjectCreate(d.Get("owner").(string), app, path, r)
+	} else if err == nil {
+		d.Set("adopted", true)
+		err = c.ConfStanzaUpdate(d.Get("owner").(string), app, conf, stanza, r)
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to create conf stanza: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, fmt.Sprintf("%s/%s", conf, stanza)))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, path, stanza, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update conf stanza ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkConfStanzaRead(d, meta)
+}
+
+// resourceSplunkConfStanzaRead only refreshes the declared variables, the
+// stanza also holds every value inherited from default and system files.
+func resourceSplunkConfStanzaRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, conf, stanza, err := parseConfStanzaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.ConfStanzaRead("-", app, conf, stanza)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	variables := make(map[string]string)
+	for k := range d.Get("variables").(map[string]interface{}) {
+		if _, ok := o.Content[k]; ok {
+			variables[k] = contentString(o.Content, k)
+		}
+	}
+
+	d.Set("app", app)
+	d.Set("conf", conf)
+	d.Set("stanza", stanza)
+	d.Set("variables", variables)
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkConfStanzaUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, conf, stanza, err := parseConfStanzaID(d.Id())
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf(PathConf, conf)
+
+	r := url.Values{}
+	setPrefixMapParams(d, r, "variables", "")
+
+	log.Printf("[DEBUG] Splunk Conf Stanza update: %s", d.Id())
+	err = c.ConfStanzaUpdate(d.Get("owner").(string), app, conf, stanza, r)
+	if err != nil {
+		return fmt.Errorf("Failed to update conf stanza: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, path, stanza, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update conf stanza ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkConfStanzaRead(d, meta)
+}
+
+// resourceSplunkConfStanzaDelete only deletes the stanzas it created, the
+// declared variables of an adopted stanza are blanked instead.
+func resourceSplunkConfStanzaDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, conf, stanza, err := parseConfStanzaID(d.Id())
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf(PathConf, conf)
+
+	if d.Get("adopted").(bool) {
+		r := url.Values{}
+		for k := range d.Get("variables").(map[string]interface{}) {
+			r.Set(k, "")
+		}
+		if len(r) == 0 {
+			return nil
+		}
+
+		log.Printf("[INFO] Blanking variables of adopted Splunk Conf Stanza: %s", d.Id())
+		err = c.ConfStanzaUpdate(d.Get("owner").(string), app, conf, stanza, r)
+		if err != nil && !strings.Contains(err.Error(), "404") {
+			return fmt.Errorf("Error blanking Splunk Conf Stanza variables: %s", err)
+		}
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting Splunk Conf Stanza: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, path, stanza)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Conf Stanza: %s", err)
+	}
+	return nil
+}
+
+// resourceSplunkConfStanzaImport marks imported stanzas as adopted, as they
+// were not created by Terraform, in the default nobody namespace.
+func resourceSplunkConfStanzaImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := parseConfStanzaID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	d.Set("owner", "nobody")
+	d.Set("adopted", true)
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseConfStanzaID splits an "app/conf/stanza" ID.
+func parseConfStanzaID(id string) (app, conf, stanza string, e error) {
+	app, name, e := parseKnowledgeObjectID(id)
+	if e != nil {
+		return
+	}
+
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		e = fmt.Errorf("Invalid ID %q, expected app/conf/stanza", id)
+		return
+	}
+
+	return app, parts[0], parts[1], nil
+}
@@ -0,0 +1,60 @@
+package splunk
+
+import (
+	"testing"
+)
+
+func TestParseConfStanzaID(t *testing.T) {
+	cases := []struct {
+		ID     string
+		App    string
+		Conf   string
+		Stanza string
+		Error  bool
+	}{
+		{
+			ID:     "search/ui-prefs/search",
+			App:    "search",
+			Conf:   "ui-prefs",
+			Stanza: "search",
+		},
+		{
+			ID:     "system/props/source::/var/log/messages",
+			App:    "system",
+			Conf:   "props",
+			Stanza: "source::/var/log/messages",
+		},
+		{
+			ID:    "search/ui-prefs",
+			Error: true,
+		},
+		{
+			ID:    "search/ui-prefs/",
+			Error: true,
+		},
+		{
+			ID:    "search//search",
+			Error: true,
+		},
+		{
+			ID:    "search",
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		app, conf, stanza, err := parseConfStanzaID(tc.ID)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("%q: expected an error", tc.ID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%q: err: %s", tc.ID, err)
+		}
+		if app != tc.App || conf != tc.Conf || stanza != tc.Stanza {
+			t.Fatalf("%q: expected %q %q %q, got %q %q %q", tc.ID, tc.App, tc.Conf, tc.Stanza, app, conf, stanza)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package splunk
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// studioDashboard is the Simple XML envelope of a Dashboard Studio definition.
+type studioDashboard struct {
+	XMLName     xml.Name `xml:"dashboard"`
+	Version     string   `xml:"version,attr"`
+	Theme       string   `xml:"theme,attr"`
+	Label       string   `xml:"label"`
+	Description string   `xml:"description"`
+	Definition  string   `xml:"definition"`
+}
+
+func resourceSplunkDashboard() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkDashboardCreate,
+		Read:   resourceSplunkDashboardRead,
+		Update: resourceSplunkDashboardUpdate,
+		Delete: resourceSplunkDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"eai_data": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"studio_definition"},
+				DiffSuppressFunc: suppressEquivalentXMLDiff,
+			},
+			"studio_definition": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"eai_data"},
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"label": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"eai_data"},
+			},
+			"description": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"eai_data"},
+			},
+			"theme": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"eai_data"},
+				ValidateFunc:  validation.StringInSlice([]string{"light", "dark"}, false),
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkDashboardCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	name := d.Get("name").(string)
+
+	r, err := dashboardParams(d)
+	if err != nil {
+		return err
+	}
+	r.Set("name", name)
+
+	log.Printf("[DEBUG] Splunk Dashboard create: %s/%s", app, name)
+	_, err = c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathViews, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create dashboard: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, name))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathViews, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update dashboard ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkDashboardRead(d, meta)
+}
+
+func resourceSplunkDashboardRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathViews, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("app", app)
+
+	data := contentString(o.Content, "eai:data")
+	studio := studioDashboard{}
+	if xml.Unmarshal([]byte(data), &studio) == nil && studio.Version == "2" {
+		d.Set("studio_definition", strings.TrimSpace(studio.Definition))
+		d.Set("label", studio.Label)
+		d.Set("description", studio.Description)
+		d.Set("theme", studio.Theme)
+	} else {
+		d.Set("eai_data", data)
+	}
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkDashboardUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	r, err := dashboardParams(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk Dashboard update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathViews, name, r)
+	if err != nil {
+		return fmt.Errorf("Failed to update dashboard: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathViews, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update dashboard ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkDashboardRead(d, meta)
+}
+
+func resourceSplunkDashboardDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Dashboard: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathViews, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Dashboard: %s", err)
+	}
+	return nil
+}
+
+// dashboardParams posts Simple XML as is, and wraps a Dashboard Studio
+// definition in its version 2 envelope.
+func dashboardParams(d *schema.ResourceData) (url.Values, error) {
+	r := url.Values{}
+
+	definition, ok := d.GetOk("studio_definition")
+	if !ok {
+		if d.Get("eai_data").(string) == "" {
+			return nil, fmt.Errorf("One of eai_data or studio_definition must be set")
+		}
+		r.Set("eai:data", d.Get("eai_data").(string))
+		return r, nil
+	}
+
+	theme := d.Get("theme").(string)
+	if theme == "" {
+		theme = "light"
+	}
+
+	b, err := xml.MarshalIndent(studioDashboard{
+		Version:     "2",
+		Theme:       theme,
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	// encoding/xml can't emit CDATA, splice the definition in place of the empty element
+	cdata := strings.Replace(definition.(string), "]]>", "]]]]><![CDATA[>", -1)
+	data := strings.Replace(string(b), "<definition></definition>", "<definition><![CDATA["+cdata+"]]></definition>", 1)
+	r.Set("eai:data", data)
+	return r, nil
+}
@@ -0,0 +1,253 @@
+package splunk
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// dataModelAcceleration is the JSON specification of the acceleration settings.
+type dataModelAcceleration struct {
+	Enabled      bool   `json:"enabled"`
+	EarliestTime string `json:"earliest_time,omitempty"`
+	CronSchedule string `json:"cron_schedule,omitempty"`
+}
+
+func resourceSplunkDataModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkDataModelCreate,
+		Read:   resourceSplunkDataModelRead,
+		Update: resourceSplunkDataModelUpdate,
+		Delete: resourceSplunkDataModelDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"definition": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"acceleration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"acceleration_earliest_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"acceleration_cron_schedule": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"acceleration_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"complete": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"in_progress": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"last_error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkDataModelCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	name := d.Get("name").(string)
+
+	r, err := dataModelParams(d)
+	if err != nil {
+		return err
+	}
+	r.Set("name", name)
+
+	log.Printf("[DEBUG] Splunk Data Model create: %s/%s", app, name)
+	_, err = c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathDataModels, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create data model: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, name))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathDataModels, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update data model ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkDataModelRead(d, meta)
+}
+
+func resourceSplunkDataModelRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathDataModels, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("app", app)
+	d.Set("definition", contentString(o.Content, "description"))
+
+	acceleration := parseDataModelAcceleration(o.Content)
+	d.Set("acceleration", acceleration.Enabled)
+	d.Set("acceleration_earliest_time", acceleration.EarliestTime)
+	d.Set("acceleration_cron_schedule", acceleration.CronSchedule)
+
+	status := []interface{}{}
+	if acceleration.Enabled {
+		// The summary only exists once a first build was scheduled.
+		s, err := c.KnowledgeObjectRead("-", app, PathSummarization, fmt.Sprintf("tstats:DM_%s_%s", app, name))
+		if err == nil {
+			complete, _ := strconv.ParseFloat(contentString(s.Content, "summary.complete"), 64)
+			status = append(status, map[string]interface{}{
+				"complete":    complete,
+				"in_progress": contentBool(s.Content, "summary.is_inprogress"),
+				"size":        contentInt(s.Content, "summary.size"),
+				"last_error":  contentString(s.Content, "summary.last_error"),
+			})
+		} else if !strings.Contains(err.Error(), "404") {
+			return err
+		}
+	}
+	d.Set("acceleration_status", status)
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkDataModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	r, err := dataModelParams(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk Data Model update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathDataModels, name, r)
+	if err != nil {
+		return fmt.Errorf("Failed to update data model: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathDataModels, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update data model ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkDataModelRead(d, meta)
+}
+
+func resourceSplunkDataModelDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Data Model: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathDataModels, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Data Model: %s", err)
+	}
+	return nil
+}
+
+// dataModelParams posts the definition as the model description, which is
+// how the endpoint names it, along with the acceleration JSON.
+func dataModelParams(d *schema.ResourceData) (url.Values, error) {
+	r := url.Values{}
+	r.Set("description", d.Get("definition").(string))
+
+	b, err := json.Marshal(dataModelAcceleration{
+		Enabled:      d.Get("acceleration").(bool),
+		EarliestTime: d.Get("acceleration_earliest_time").(string),
+		CronSchedule: d.Get("acceleration_cron_schedule").(string),
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.Set("acceleration", string(b))
+	return r, nil
+}
+
+// parseDataModelAcceleration reads the acceleration JSON, falling back to the
+// flat datamodels.conf keys some Splunk versions return.
+func parseDataModelAcceleration(content map[string]interface{}) (a dataModelAcceleration) {
+	if json.Unmarshal([]byte(contentString(content, "acceleration")), &a) == nil {
+		return
+	}
+
+	a.Enabled = contentBool(content, "acceleration")
+	a.EarliestTime = contentString(content, "acceleration.earliest_time")
+	a.CronSchedule = contentString(content, "acceleration.cron_schedule")
+	return
+}
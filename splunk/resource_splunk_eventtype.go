@@ -0,0 +1,183 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceSplunkEventType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkEventTypeCreate,
+		Read:   resourceSplunkEventTypeRead,
+		Update: resourceSplunkEventTypeUpdate,
+		Delete: resourceSplunkEventTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"search": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"color": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkEventTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	name := d.Get("name").(string)
+
+	r := eventTypeParams(d)
+	r.Set("name", name)
+
+	log.Printf("[DEBUG] Splunk Event Type create: %s/%s", app, name)
+	_, err := c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathEventTypes, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create event type: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, name))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathEventTypes, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update event type ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkEventTypeRead(d, meta)
+}
+
+func resourceSplunkEventTypeRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathEventTypes, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("app", app)
+	d.Set("search", contentString(o.Content, "search"))
+	d.Set("priority", contentInt(o.Content, "priority"))
+	d.Set("color", contentString(o.Content, "color"))
+	d.Set("description", contentString(o.Content, "description"))
+	d.Set("tags", contentStringList(o.Content, "tags"))
+	d.Set("disabled", contentBool(o.Content, "disabled"))
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkEventTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk Event Type update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathEventTypes, name, eventTypeParams(d))
+	if err != nil {
+		return fmt.Errorf("Failed to update event type: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathEventTypes, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update event type ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkEventTypeRead(d, meta)
+}
+
+func resourceSplunkEventTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Event Type: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathEventTypes, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Event Type: %s", err)
+	}
+	return nil
+}
+
+func eventTypeParams(d *schema.ResourceData) url.Values {
+	r := url.Values{}
+	r.Set("search", d.Get("search").(string))
+	r.Set("priority", strconv.Itoa(d.Get("priority").(int)))
+	r.Set("color", d.Get("color").(string))
+	r.Set("description", d.Get("description").(string))
+	r.Set("tags", strings.Join(stringArrayFromInterface(d.Get("tags").([]interface{})), ","))
+	r.Set("disabled", strconv.FormatBool(d.Get("disabled").(bool)))
+	return r
+}
@@ -0,0 +1,160 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkFieldAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkFieldAliasCreate,
+		Read:   resourceSplunkFieldAliasRead,
+		Update: resourceSplunkFieldAliasUpdate,
+		Delete: resourceSplunkFieldAliasDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stanza": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"aliases": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkFieldAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	stanza := d.Get("stanza").(string)
+	name := d.Get("name").(string)
+
+	r := url.Values{}
+	r.Set("name", name)
+	r.Set("stanza", stanza)
+	setPrefixMapParams(d, r, "aliases", "alias.")
+
+	log.Printf("[DEBUG] Splunk Field Alias create: %s/%s", app, name)
+	_, err := c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathPropsFieldAliases, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create field alias: %s", err)
+	}
+
+	entity := propsEntityName(stanza, "FIELDALIAS", name)
+	d.SetId(knowledgeObjectID(app, entity))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathPropsFieldAliases, entity, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update field alias ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkFieldAliasRead(d, meta)
+}
+
+func resourceSplunkFieldAliasRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, entity, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	stanza, _, name, err := parsePropsEntityName(entity)
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathPropsFieldAliases, entity)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("stanza", stanza)
+	d.Set("app", app)
+	d.Set("aliases", contentPrefixMap(o.Content, "alias."))
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkFieldAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, entity, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	r := url.Values{}
+	setPrefixMapParams(d, r, "aliases", "alias.")
+
+	log.Printf("[DEBUG] Splunk Field Alias update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathPropsFieldAliases, entity, r)
+	if err != nil {
+		return fmt.Errorf("Failed to update field alias: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathPropsFieldAliases, entity, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update field alias ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkFieldAliasRead(d, meta)
+}
+
+func resourceSplunkFieldAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, entity, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Field Alias: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathPropsFieldAliases, entity)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Field Alias: %s", err)
+	}
+	return nil
+}
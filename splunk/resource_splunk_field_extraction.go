@@ -0,0 +1,186 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Extraction types as exposed by the provider, and as named by the REST API.
+var fieldExtractionTypes = map[string]string{
+	"inline":         "Inline",
+	"uses-transform": "Uses transform",
+}
+
+// props.conf class of each extraction type.
+var fieldExtractionClasses = map[string]string{
+	"inline":         "EXTRACT",
+	"uses-transform": "REPORT",
+}
+
+func resourceSplunkFieldExtraction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkFieldExtractionCreate,
+		Read:   resourceSplunkFieldExtractionRead,
+		Update: resourceSplunkFieldExtractionUpdate,
+		Delete: resourceSplunkFieldExtractionDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stanza": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"type": {
+				ForceNew:     true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "inline",
+				ValidateFunc: validation.StringInSlice([]string{"inline", "uses-transform"}, false),
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkFieldExtractionCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	stanza := d.Get("stanza").(string)
+	name := d.Get("name").(string)
+	t := d.Get("type").(string)
+
+	r := url.Values{}
+	r.Set("name", name)
+	r.Set("stanza", stanza)
+	r.Set("type", fieldExtractionTypes[t])
+	r.Set("value", d.Get("value").(string))
+
+	log.Printf("[DEBUG] Splunk Field Extraction create: %s/%s", app, name)
+	_, err := c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathPropsExtractions, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create field extraction: %s", err)
+	}
+
+	entity := propsEntityName(stanza, fieldExtractionClasses[t], name)
+	d.SetId(knowledgeObjectID(app, entity))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathPropsExtractions, entity, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update field extraction ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkFieldExtractionRead(d, meta)
+}
+
+func resourceSplunkFieldExtractionRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, entity, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	stanza, _, name, err := parsePropsEntityName(entity)
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathPropsExtractions, entity)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	t := contentString(o.Content, "type")
+	for k, v := range fieldExtractionTypes {
+		if v == t {
+			d.Set("type", k)
+		}
+	}
+
+	d.Set("name", name)
+	d.Set("stanza", stanza)
+	d.Set("app", app)
+	d.Set("value", contentString(o.Content, "value"))
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkFieldExtractionUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, entity, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	r := url.Values{}
+	r.Set("value", d.Get("value").(string))
+
+	log.Printf("[DEBUG] Splunk Field Extraction update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathPropsExtractions, entity, r)
+	if err != nil {
+		return fmt.Errorf("Failed to update field extraction: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathPropsExtractions, entity, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update field extraction ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkFieldExtractionRead(d, meta)
+}
+
+func resourceSplunkFieldExtractionDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, entity, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Field Extraction: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathPropsExtractions, entity)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Field Extraction: %s", err)
+	}
+	return nil
+}
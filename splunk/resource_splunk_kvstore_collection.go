@@ -0,0 +1,194 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceSplunkKVStoreCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkKVStoreCollectionCreate,
+		Read:   resourceSplunkKVStoreCollectionRead,
+		Update: resourceSplunkKVStoreCollectionUpdate,
+		Delete: resourceSplunkKVStoreCollectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"fields": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateMapValues(validation.StringInSlice([]string{"array", "number", "bool", "string", "cidr", "time"}, false)),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"accelerated_fields": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateMapValues(validation.ValidateJsonString),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"enforce_types": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"replicate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkKVStoreCollectionCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	name := d.Get("name").(string)
+
+	r := url.Values{}
+	r.Set("name", name)
+
+	log.Printf("[DEBUG] Splunk KV Store Collection create: %s/%s", app, name)
+	_, err := c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathKVStoreCollections, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create KV store collection: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, name))
+
+	// Fields are only accepted on the collection entity, not on creation.
+	_, err = c.KnowledgeObjectUpdate("-", app, PathKVStoreCollections, name, kvStoreCollectionParams(d))
+	if err != nil {
+		return fmt.Errorf("Failed to configure KV store collection: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathKVStoreCollections, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update KV store collection ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkKVStoreCollectionRead(d, meta)
+}
+
+func resourceSplunkKVStoreCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathKVStoreCollections, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("app", app)
+	d.Set("fields", contentPrefixMap(o.Content, "field."))
+	d.Set("accelerated_fields", contentPrefixMap(o.Content, "accelerated_fields."))
+	d.Set("enforce_types", contentBool(o.Content, "enforceTypes"))
+	d.Set("replicate", contentBool(o.Content, "replicate"))
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkKVStoreCollectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk KV Store Collection update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathKVStoreCollections, name, kvStoreCollectionParams(d))
+	if err != nil {
+		return fmt.Errorf("Failed to update KV store collection: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathKVStoreCollections, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update KV store collection ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkKVStoreCollectionRead(d, meta)
+}
+
+func resourceSplunkKVStoreCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk KV Store Collection: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathKVStoreCollections, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk KV Store Collection: %s", err)
+	}
+	return nil
+}
+
+func kvStoreCollectionParams(d *schema.ResourceData) url.Values {
+	r := url.Values{}
+	r.Set("enforceTypes", strconv.FormatBool(d.Get("enforce_types").(bool)))
+	r.Set("replicate", strconv.FormatBool(d.Get("replicate").(bool)))
+	setPrefixMapParams(d, r, "fields", "field.")
+	setPrefixMapParams(d, r, "accelerated_fields", "accelerated_fields.")
+	return r
+}
+
+// validateMapValues applies f to every value of a map attribute, as the
+// ValidateFunc of a map Elem is never called.
+func validateMapValues(f schema.SchemaValidateFunc) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, es []error) {
+		for key, value := range v.(map[string]interface{}) {
+			w, e := f(value, fmt.Sprintf("%s.%s", k, key))
+			ws = append(ws, w...)
+			es = append(es, e...)
+		}
+		return
+	}
+}
@@ -0,0 +1,240 @@
+package splunk
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceSplunkKVStoreRecords() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkKVStoreRecordsCreate,
+		Read:   resourceSplunkKVStoreRecordsRead,
+		Update: resourceSplunkKVStoreRecordsUpdate,
+		Delete: resourceSplunkKVStoreRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceSplunkKVStoreRecordsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"collection": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"records": {
+				Type:             schema.TypeMap,
+				Required:         true,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				ValidateFunc:     validateMapValues(validation.ValidateJsonString),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceSplunkKVStoreRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	collection := d.Get("collection").(string)
+
+	records, err := kvStoreRecordsFromMap(d.Get("records").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk KV Store Records create: %s/%s (%d records)", app, collection, len(records))
+	err = c.KVStoreRecordsBatchSave(d.Get("owner").(string), app, collection, records)
+	if err != nil {
+		return fmt.Errorf("Failed to save KV store records: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, collection))
+
+	return resourceSplunkKVStoreRecordsRead(d, meta)
+}
+
+// resourceSplunkKVStoreRecordsRead only refreshes the records whose key is
+// managed, other records of the collection are left alone.
+func resourceSplunkKVStoreRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, collection, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	remote, err := c.KVStoreRecordsRead(d.Get("owner").(string), app, collection)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	remoteByKey, err := kvStoreRecordsToMap(remote)
+	if err != nil {
+		return err
+	}
+
+	records := make(map[string]string)
+	for k := range d.Get("records").(map[string]interface{}) {
+		if v, ok := remoteByKey[k]; ok {
+			records[k] = v
+		}
+	}
+
+	d.Set("app", app)
+	d.Set("collection", collection)
+	return d.Set("records", records)
+}
+
+func resourceSplunkKVStoreRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, collection, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+	owner := d.Get("owner").(string)
+
+	o, n := d.GetChange("records")
+	old := o.(map[string]interface{})
+	current := n.(map[string]interface{})
+
+	for k := range old {
+		if _, ok := current[k]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Splunk KV Store Record deletion: %s/%s", d.Id(), k)
+		err = c.KVStoreRecordDelete(owner, app, collection, k)
+		if err != nil && !strings.Contains(err.Error(), "404") {
+			return fmt.Errorf("Failed to delete KV store record %s: %s", k, err)
+		}
+	}
+
+	changed := make(map[string]interface{})
+	for k, v := range current {
+		if ov, ok := old[k]; !ok || ov != v {
+			changed[k] = v
+		}
+	}
+
+	records, err := kvStoreRecordsFromMap(changed)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk KV Store Records update: %s (%d records)", d.Id(), len(records))
+	err = c.KVStoreRecordsBatchSave(owner, app, collection, records)
+	if err != nil {
+		return fmt.Errorf("Failed to save KV store records: %s", err)
+	}
+
+	return resourceSplunkKVStoreRecordsRead(d, meta)
+}
+
+func resourceSplunkKVStoreRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, collection, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk KV Store Records: %s", d.Id())
+	for k := range d.Get("records").(map[string]interface{}) {
+		err = c.KVStoreRecordDelete(d.Get("owner").(string), app, collection, k)
+		if err != nil && !strings.Contains(err.Error(), "404") {
+			return fmt.Errorf("Error deleting Splunk KV Store Record %s: %s", k, err)
+		}
+	}
+	return nil
+}
+
+// resourceSplunkKVStoreRecordsImport adopts every record of the collection,
+// read in the owner namespace given as owner/app/collection, else nobody.
+func resourceSplunkKVStoreRecordsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	c := meta.(*Client)
+
+	owner := "nobody"
+	id := d.Id()
+	if parts := strings.SplitN(id, "/", 3); len(parts) == 3 {
+		owner, id = parts[0], knowledgeObjectID(parts[1], parts[2])
+	}
+
+	app, collection, err := parseKnowledgeObjectID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := c.KVStoreRecordsRead(owner, app, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := kvStoreRecordsToMap(remote)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	d.Set("owner", owner)
+	d.Set("records", records)
+	return []*schema.ResourceData{d}, nil
+}
+
+// kvStoreRecordsFromMap turns a key => JSON document map into records carrying their _key.
+func kvStoreRecordsFromMap(m map[string]interface{}) ([]map[string]interface{}, error) {
+	records := make([]map[string]interface{}, 0, len(m))
+	for k, v := range m {
+		record := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(v.(string)), &record); err != nil {
+			return nil, fmt.Errorf("Invalid KV store record %s: %s", k, err)
+		}
+		record["_key"] = k
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// kvStoreRecordsToMap turns records into a key => JSON document map, without
+// the _key and _user system fields so that it compares with jsonencode output.
+func kvStoreRecordsToMap(records []map[string]interface{}) (map[string]string, error) {
+	m := make(map[string]string)
+	for _, record := range records {
+		key := fmt.Sprint(record["_key"])
+		delete(record, "_key")
+		delete(record, "_user")
+
+		b, err := json.Marshal(record)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = string(b)
+	}
+	return m, nil
+}
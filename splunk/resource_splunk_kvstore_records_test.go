@@ -0,0 +1,59 @@
+package splunk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKVStoreRecordsToMap(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Records  []map[string]interface{}
+		Expected map[string]string
+	}{
+		{
+			Name:     "empty",
+			Records:  nil,
+			Expected: map[string]string{},
+		},
+		{
+			Name: "system fields",
+			Records: []map[string]interface{}{
+				{"_key": "web01", "_user": "nobody", "owner": "ops"},
+			},
+			Expected: map[string]string{
+				"web01": `{"owner":"ops"}`,
+			},
+		},
+		{
+			Name: "sorted fields",
+			Records: []map[string]interface{}{
+				{"_key": "db01", "zone": "b", "owner": "dba", "port": float64(5432)},
+			},
+			Expected: map[string]string{
+				"db01": `{"owner":"dba","port":5432,"zone":"b"}`,
+			},
+		},
+		{
+			Name: "several records",
+			Records: []map[string]interface{}{
+				{"_key": "web01", "owner": "ops"},
+				{"_key": "db01", "owner": "dba", "tags": []interface{}{"prod"}},
+			},
+			Expected: map[string]string{
+				"web01": `{"owner":"ops"}`,
+				"db01":  `{"owner":"dba","tags":["prod"]}`,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := kvStoreRecordsToMap(tc.Records)
+		if err != nil {
+			t.Fatalf("%s: err: %s", tc.Name, err)
+		}
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Fatalf("%s: expected %v, got %v", tc.Name, tc.Expected, got)
+		}
+	}
+}
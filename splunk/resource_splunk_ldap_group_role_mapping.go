@@ -0,0 +1,135 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkLDAPGroupRoleMapping() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkLDAPGroupRoleMappingCreate,
+		Read:   resourceSplunkLDAPGroupRoleMappingRead,
+		Update: resourceSplunkLDAPGroupRoleMappingUpdate,
+		Delete: resourceSplunkLDAPGroupRoleMappingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"strategy": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceSplunkLDAPGroupRoleMappingCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	strategy := d.Get("strategy").(string)
+	group := d.Get("group").(string)
+
+	log.Printf("[DEBUG] Splunk LDAP Group Role Mapping create: %s/%s", strategy, group)
+	err := c.LDAPGroupRolesUpdate(strategy, group, stringArrayFromInterface(d.Get("roles").(*schema.Set).List()))
+	if err != nil {
+		return fmt.Errorf("Failed to create LDAP group role mapping: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", strategy, group))
+	return resourceSplunkLDAPGroupRoleMappingRead(d, meta)
+}
+
+func resourceSplunkLDAPGroupRoleMappingRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	strategy, group, err := parseLDAPGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	roles, err := c.LDAPGroupRolesRead(strategy, group)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// A group without roles is no longer mapped
+	if len(roles) == 0 {
+		log.Printf("[WARN] Removing resource from state because the group is not mapped to any role")
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("strategy", strategy)
+	d.Set("group", group)
+	return d.Set("roles", roles)
+}
+
+func resourceSplunkLDAPGroupRoleMappingUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	strategy, group, err := parseLDAPGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk LDAP Group Role Mapping update: %s", d.Id())
+	err = c.LDAPGroupRolesUpdate(strategy, group, stringArrayFromInterface(d.Get("roles").(*schema.Set).List()))
+	if err != nil {
+		return fmt.Errorf("Failed to update LDAP group role mapping: %s", err)
+	}
+
+	return resourceSplunkLDAPGroupRoleMappingRead(d, meta)
+}
+
+// resourceSplunkLDAPGroupRoleMappingDelete unmaps the group, which itself
+// lives in the directory and can't be deleted.
+func resourceSplunkLDAPGroupRoleMappingDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	strategy, group, err := parseLDAPGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk LDAP Group Role Mapping: %s", d.Id())
+	err = c.LDAPGroupRolesUpdate(strategy, group, nil)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk LDAP Group Role Mapping: %s", err)
+	}
+	return nil
+}
+
+// parseLDAPGroupID splits a "strategy/group" ID. The group name may itself
+// contain slashes.
+func parseLDAPGroupID(id string) (strategy, group string, e error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		e = fmt.Errorf("Invalid ID %q, expected strategy/group", id)
+		return
+	}
+
+	return parts[0], parts[1], nil
+}
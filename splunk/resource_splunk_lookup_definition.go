@@ -0,0 +1,224 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkLookupDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkLookupDefinitionCreate,
+		Read:   resourceSplunkLookupDefinitionRead,
+		Update: resourceSplunkLookupDefinitionUpdate,
+		Delete: resourceSplunkLookupDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"filename": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"collection"},
+			},
+			"collection": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename"},
+			},
+			"fields_list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"case_sensitive_match": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"match_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"min_matches": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"max_matches": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"default_match": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkLookupDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	name := d.Get("name").(string)
+
+	if d.Get("filename").(string) == "" && d.Get("collection").(string) == "" {
+		return fmt.Errorf("One of filename or collection must be set")
+	}
+
+	r := lookupDefinitionParams(d)
+	r.Set("name", name)
+
+	log.Printf("[DEBUG] Splunk Lookup Definition create: %s/%s", app, name)
+	_, err := c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathLookupDefinitions, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create lookup definition: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, name))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathLookupDefinitions, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update lookup definition ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkLookupDefinitionRead(d, meta)
+}
+
+func resourceSplunkLookupDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathLookupDefinitions, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("app", app)
+	d.Set("filename", contentString(o.Content, "filename"))
+	d.Set("collection", contentString(o.Content, "collection"))
+	d.Set("fields_list", contentStringList(o.Content, "fields_list"))
+	d.Set("case_sensitive_match", contentBool(o.Content, "case_sensitive_match"))
+	d.Set("match_type", contentString(o.Content, "match_type"))
+	d.Set("min_matches", contentInt(o.Content, "min_matches"))
+	d.Set("max_matches", contentInt(o.Content, "max_matches"))
+	d.Set("default_match", contentString(o.Content, "default_match"))
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkLookupDefinitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk Lookup Definition update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathLookupDefinitions, name, lookupDefinitionParams(d))
+	if err != nil {
+		return fmt.Errorf("Failed to update lookup definition: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathLookupDefinitions, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update lookup definition ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkLookupDefinitionRead(d, meta)
+}
+
+func resourceSplunkLookupDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Lookup Definition: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathLookupDefinitions, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Lookup Definition: %s", err)
+	}
+	return nil
+}
+
+func lookupDefinitionParams(d *schema.ResourceData) url.Values {
+	r := url.Values{}
+
+	// The other source is blanked, a definition switched from a file to a
+	// collection would otherwise keep its filename.
+	if v, ok := d.GetOk("collection"); ok {
+		r.Set("external_type", "kvstore")
+		r.Set("collection", v.(string))
+		r.Set("filename", "")
+	} else {
+		r.Set("external_type", "")
+		r.Set("collection", "")
+		r.Set("filename", d.Get("filename").(string))
+	}
+
+	if v, ok := d.GetOk("fields_list"); ok {
+		r.Set("fields_list", strings.Join(stringArrayFromInterface(v.([]interface{})), ","))
+	}
+	r.Set("case_sensitive_match", strconv.FormatBool(d.Get("case_sensitive_match").(bool)))
+	if v, ok := d.GetOk("match_type"); ok {
+		r.Set("match_type", v.(string))
+	}
+	if v, ok := d.GetOk("min_matches"); ok {
+		r.Set("min_matches", strconv.Itoa(v.(int)))
+	}
+	if v, ok := d.GetOk("max_matches"); ok {
+		r.Set("max_matches", strconv.Itoa(v.(int)))
+	}
+	if v, ok := d.GetOk("default_match"); ok {
+		r.Set("default_match", v.(string))
+	}
+	return r
+}
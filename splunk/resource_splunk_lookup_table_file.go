@@ -0,0 +1,224 @@
+package splunk
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkLookupTableFile() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceSplunkLookupTableFileCreate,
+		Read:          resourceSplunkLookupTableFileRead,
+		Update:        resourceSplunkLookupTableFileUpdate,
+		Delete:        resourceSplunkLookupTableFileDelete,
+		CustomizeDiff: resourceSplunkLookupTableFileCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"source": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"content"},
+			},
+			"content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source"},
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkLookupTableFileCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	name := d.Get("name").(string)
+
+	rows, err := lookupTableFileRows(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk Lookup Table File create: %s/%s (%d rows)", app, name, len(rows))
+	err = c.LookupTableFileContentsWrite(d.Get("owner").(string), app, name, rows)
+	if err != nil {
+		return fmt.Errorf("Failed to create lookup table file: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, name))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathLookupTableFiles, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update lookup table file ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkLookupTableFileRead(d, meta)
+}
+
+func resourceSplunkLookupTableFileRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathLookupTableFiles, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	rows, err := c.LookupTableFileContentsRead(o.ACL.Owner, app, name)
+	if err != nil {
+		return err
+	}
+
+	checksum, err := lookupChecksum(rows)
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("app", app)
+	d.Set("checksum", checksum)
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkLookupTableFileUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("checksum") {
+		rows, err := lookupTableFileRows(d)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Splunk Lookup Table File update: %s (%d rows)", d.Id(), len(rows))
+		err = c.LookupTableFileContentsWrite(d.Get("owner").(string), app, name, rows)
+		if err != nil {
+			return fmt.Errorf("Failed to update lookup table file: %s", err)
+		}
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathLookupTableFiles, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update lookup table file ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkLookupTableFileRead(d, meta)
+}
+
+func resourceSplunkLookupTableFileDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Lookup Table File: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathLookupTableFiles, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Lookup Table File: %s", err)
+	}
+	return nil
+}
+
+// resourceSplunkLookupTableFileCustomizeDiff compares the checksum of the
+// configured CSV with the one read from Splunk to detect content drift. The
+// checksum is only known at apply time when the CSV comes from another
+// resource.
+func resourceSplunkLookupTableFileCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source") || !d.NewValueKnown("content") {
+		return d.SetNewComputed("checksum")
+	}
+
+	if d.Get("source").(string) == "" && d.Get("content").(string) == "" {
+		return fmt.Errorf("One of source or content must be set")
+	}
+
+	content, err := lookupTableFileContent(d.Get("source").(string), d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+
+	rows, err := parseLookupCSV(content)
+	if err != nil {
+		return fmt.Errorf("Invalid lookup table file CSV: %s", err)
+	}
+
+	checksum, err := lookupChecksum(rows)
+	if err != nil {
+		return err
+	}
+
+	if d.Get("checksum").(string) != checksum {
+		return d.SetNew("checksum", checksum)
+	}
+	return nil
+}
+
+func lookupTableFileRows(d *schema.ResourceData) ([][]string, error) {
+	content, err := lookupTableFileContent(d.Get("source").(string), d.Get("content").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseLookupCSV(content)
+}
+
+func lookupTableFileContent(source, content string) (string, error) {
+	if source == "" {
+		return content, nil
+	}
+
+	b, err := ioutil.ReadFile(source)
+	if err != nil {
+		return "", fmt.Errorf("Failed to read lookup table file source %s: %s", source, err)
+	}
+	return string(b), nil
+}
@@ -0,0 +1,180 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkMacro() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkMacroCreate,
+		Read:   resourceSplunkMacroRead,
+		Update: resourceSplunkMacroUpdate,
+		Delete: resourceSplunkMacroDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"definition": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"args": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"validation": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"errormsg": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"iseval": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkMacroCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	name := d.Get("name").(string)
+
+	r := macroParams(d)
+	r.Set("name", name)
+
+	log.Printf("[DEBUG] Splunk Macro create: %s/%s", app, name)
+	_, err := c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathMacros, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create macro: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, name))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathMacros, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update macro ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkMacroRead(d, meta)
+}
+
+func resourceSplunkMacroRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathMacros, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("app", app)
+	d.Set("definition", contentString(o.Content, "definition"))
+	d.Set("args", contentStringList(o.Content, "args"))
+	d.Set("validation", contentString(o.Content, "validation"))
+	d.Set("errormsg", contentString(o.Content, "errormsg"))
+	d.Set("iseval", contentBool(o.Content, "iseval"))
+	d.Set("description", contentString(o.Content, "description"))
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkMacroUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk Macro update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathMacros, name, macroParams(d))
+	if err != nil {
+		return fmt.Errorf("Failed to update macro: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathMacros, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update macro ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkMacroRead(d, meta)
+}
+
+func resourceSplunkMacroDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Macro: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathMacros, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Macro: %s", err)
+	}
+	return nil
+}
+
+func macroParams(d *schema.ResourceData) url.Values {
+	r := url.Values{}
+	r.Set("definition", d.Get("definition").(string))
+	r.Set("args", strings.Join(stringArrayFromInterface(d.Get("args").([]interface{})), ","))
+	r.Set("validation", d.Get("validation").(string))
+	r.Set("errormsg", d.Get("errormsg").(string))
+	r.Set("iseval", strconv.FormatBool(d.Get("iseval").(bool)))
+	r.Set("description", d.Get("description").(string))
+	return r
+}
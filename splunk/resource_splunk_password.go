@@ -0,0 +1,171 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkPassword() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkPasswordCreate,
+		Read:   resourceSplunkPasswordRead,
+		Update: resourceSplunkPasswordUpdate,
+		Delete: resourceSplunkPasswordDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"realm": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"username": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+				StateFunc: hashSecret,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkPasswordCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	realm := d.Get("realm").(string)
+	username := d.Get("username").(string)
+
+	r := url.Values{}
+	r.Set("name", username)
+	r.Set("password", d.Get("password").(string))
+	if realm != "" {
+		r.Set("realm", realm)
+	}
+
+	log.Printf("[DEBUG] Splunk Password create: %s/%s", app, passwordEntityName(realm, username))
+	_, err := c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathPasswords, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create password: %s", err)
+	}
+
+	name := passwordEntityName(realm, username)
+	d.SetId(knowledgeObjectID(app, name))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathPasswords, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update password ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkPasswordRead(d, meta)
+}
+
+func resourceSplunkPasswordRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathPasswords, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("app", app)
+	d.Set("realm", contentString(o.Content, "realm"))
+	d.Set("username", contentString(o.Content, "username"))
+
+	// clear_password is only returned to users holding list_storage_passwords,
+	// otherwise the last applied digest is kept.
+	if v, ok := o.Content["clear_password"]; ok && v != nil {
+		d.Set("password", hashSecret(contentString(o.Content, "clear_password")))
+	}
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkPasswordUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("password") {
+		r := url.Values{}
+		r.Set("password", d.Get("password").(string))
+
+		log.Printf("[DEBUG] Splunk Password update: %s", d.Id())
+		_, err = c.KnowledgeObjectUpdate("-", app, PathPasswords, name, r)
+		if err != nil {
+			return fmt.Errorf("Failed to update password: %s", err)
+		}
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathPasswords, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update password ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkPasswordRead(d, meta)
+}
+
+func resourceSplunkPasswordDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Password: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathPasswords, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Password: %s", err)
+	}
+	return nil
+}
+
+// passwordEntityName returns the "realm:username:" name of a stored
+// credential, in which colons of either part are escaped.
+func passwordEntityName(realm, username string) string {
+	escape := func(s string) string { return strings.Replace(s, ":", `\:`, -1) }
+	return fmt.Sprintf("%s:%s:", escape(realm), escape(username))
+}
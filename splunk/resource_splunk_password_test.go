@@ -0,0 +1,40 @@
+package splunk
+
+import (
+	"testing"
+)
+
+func TestPasswordEntityName(t *testing.T) {
+	cases := []struct {
+		Realm    string
+		Username string
+		Expected string
+	}{
+		{
+			Realm:    "",
+			Username: "svc_account",
+			Expected: ":svc_account:",
+		},
+		{
+			Realm:    "aws",
+			Username: "svc_account",
+			Expected: "aws:svc_account:",
+		},
+		{
+			Realm:    "https://api.example.com:443",
+			Username: "svc_account",
+			Expected: `https\://api.example.com\:443:svc_account:`,
+		},
+		{
+			Realm:    "ldap",
+			Username: "CORP:jdoe",
+			Expected: `ldap:CORP\:jdoe:`,
+		},
+	}
+
+	for _, tc := range cases {
+		if got := passwordEntityName(tc.Realm, tc.Username); got != tc.Expected {
+			t.Fatalf("%q %q: expected %q, got %q", tc.Realm, tc.Username, tc.Expected, got)
+		}
+	}
+}
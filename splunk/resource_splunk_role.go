@@ -8,6 +8,7 @@ import (
     "fmt"
     "encoding/json"
     "net/url"
+    "strconv"
 )
 
 func resourceSplunkRole() *schema.Resource {
@@ -44,6 +45,67 @@ func resourceSplunkRole() *schema.Resource {
                                   Type:         schema.TypeString,
                                 },
                         },
+                        "srch_indexes_default": {
+                                Type:     schema.TypeList,
+                                Optional: true,
+                                Elem: &schema.Schema{
+                                  Type:         schema.TypeString,
+                                },
+                        },
+                        "capabilities": {
+                                Type:     schema.TypeList,
+                                Optional: true,
+                                Elem: &schema.Schema{
+                                  Type:         schema.TypeString,
+                                },
+                        },
+                        "grantable_roles": {
+                                Type:     schema.TypeList,
+                                Optional: true,
+                                Elem: &schema.Schema{
+                                  Type:         schema.TypeString,
+                                },
+                        },
+                        "default_app": {
+                                Type:     schema.TypeString,
+                                Optional: true,
+                                Default:  "search",
+                        },
+                        "srch_jobs_quota": {
+                                Type:     schema.TypeInt,
+                                Optional: true,
+                                Computed: true,
+                        },
+                        "rt_srch_jobs_quota": {
+                                Type:     schema.TypeInt,
+                                Optional: true,
+                                Computed: true,
+                        },
+                        "cumulative_srch_jobs_quota": {
+                                Type:     schema.TypeInt,
+                                Optional: true,
+                                Computed: true,
+                        },
+                        "srch_disk_quota": {
+                                Type:     schema.TypeInt,
+                                Optional: true,
+                                Computed: true,
+                        },
+                        "srch_time_win": {
+                                Type:     schema.TypeInt,
+                                Optional: true,
+                                Computed: true,
+                        },
+                        "srch_time_earliest": {
+                                Type:     schema.TypeInt,
+                                Optional: true,
+                                Computed: true,
+                        },
+                        "force_detach": {
+                                Type:     schema.TypeBool,
+                                Optional: true,
+                                Default:  false,
+                        },
                 },
         }
 }
@@ -51,18 +113,8 @@ func resourceSplunkRole() *schema.Resource {
 func resourceSplunkRoleCreate(d *schema.ResourceData, meta interface{}) error {
         c := meta.(*Client)
 
-	    r := url.Values{}
-        r.Set("name",       d.Get("name").(string))
-        r.Set("srchFilter", d.Get("search_filter").(string))
-        r.Set("defaultApp", "search")
-
-        for _, element := range d.Get("indexes_allowed").([]interface{}) {
-            r.Add("srchIndexesAllowed", element.(string))
-        }
-
-        for _, element := range d.Get("imported_roles").([]interface{}) {
-            r.Add("imported_roles", element.(string))
-        }
+        r := roleParams(d)
+        r.Set("name", d.Get("name").(string))
 
         _, err := c.Post(PathRoleCreate, r)
         if  err != nil  {
@@ -121,6 +173,19 @@ func resourceSplunkRoleRead(d *schema.ResourceData, meta interface{}) error {
         }
         d.Set("imported_roles", s)
 
+        res, err = jsonpath.JsonPathLookup(data, "$.entry[0].content")
+        if err != nil {
+            return err
+        }
+        content := res.(map[string]interface{})
+        d.Set("default_app", contentString(content, "defaultApp"))
+        for k, key := range roleListKeys {
+            d.Set(k, contentStringList(content, key))
+        }
+        for k, key := range roleIntKeys {
+            d.Set(k, contentInt(content, key))
+        }
+
         log.Printf("[DEBUG] Splunk Role Read: %s", d.Get("name").(string))
 
         return err
@@ -129,10 +194,36 @@ func resourceSplunkRoleRead(d *schema.ResourceData, meta interface{}) error {
 func resourceSplunkRoleUpdate(d *schema.ResourceData, meta interface{}) error {
         c := meta.(*Client)
 
-	r := url.Values{}
-        //r.Set("name",       d.Get("name").(string))
+        log.Printf("[DEBUG] Splunk Role Update: %s", d.Get("name").(string))
+        _, err := c.Post(fmt.Sprintf(PathRoleSearch, url.QueryEscape(d.Id())), roleParams(d))
+        if  err != nil  {
+            return fmt.Errorf("Failed to update role: %s", err)
+        }
+
+        return resourceSplunkRoleRead(d, meta)
+}
+
+// Role keys behind the list arguments added on top of indexes_allowed and imported_roles.
+var roleListKeys = map[string]string{
+        "srch_indexes_default": "srchIndexesDefault",
+        "capabilities":         "capabilities",
+        "grantable_roles":      "grantable_roles",
+}
+
+// Role keys behind the quota arguments.
+var roleIntKeys = map[string]string{
+        "srch_jobs_quota":            "srchJobsQuota",
+        "rt_srch_jobs_quota":         "rtSrchJobsQuota",
+        "cumulative_srch_jobs_quota": "cumulativeSrchJobsQuota",
+        "srch_disk_quota":            "srchDiskQuota",
+        "srch_time_win":              "srchTimeWin",
+        "srch_time_earliest":         "srchTimeEarliest",
+}
+
+func roleParams(d *schema.ResourceData) url.Values {
+        r := url.Values{}
         r.Set("srchFilter", d.Get("search_filter").(string))
-        r.Set("defaultApp", "search")
+        r.Set("defaultApp", d.Get("default_app").(string))
 
         for _, element := range d.Get("indexes_allowed").([]interface{}) {
             r.Add("srchIndexesAllowed", element.(string))
@@ -142,42 +233,67 @@ func resourceSplunkRoleUpdate(d *schema.ResourceData, meta interface{}) error {
             r.Add("imported_roles", element.(string))
         }
 
-        log.Printf("[DEBUG] Splunk Role Update: %s", d.Get("name").(string))
-        _, err := c.Post(fmt.Sprintf(PathRoleSearch, url.QueryEscape(d.Id())), r)
-        if  err != nil  {
-            return fmt.Errorf("Failed to update role: %s", err)
+        // An empty value clears the list
+        for k, key := range roleListKeys {
+            elements := d.Get(k).([]interface{})
+            if len(elements) == 0 {
+                r.Set(key, "")
+            }
+            for _, element := range elements {
+                r.Add(key, element.(string))
+            }
         }
 
-        return resourceSplunkRoleRead(d, meta)
+        // Quotas left unset keep their Splunk default, -1 or 0 may be meaningful
+        for k, key := range roleIntKeys {
+            if v, ok := d.GetOkExists(k); ok {
+                r.Set(key, strconv.Itoa(v.(int)))
+            }
+        }
+
+        return r
 }
 
 func resourceSplunkRoleDelete(d *schema.ResourceData, meta interface{}) error {
         c := meta.(*Client)
-        c.client.SetQueryParam("count", "0")
 
-        log.Printf("[DEBUG] Search for users having this role: %s", d.Id())
-        responseTxt, err := c.Get(PathUserCreate)
+        log.Printf("[DEBUG] Search for users and roles having this role: %s", d.Id())
+        users, err := roleHolders(c, PathUserCreate, "roles", d.Id())
         if err != nil {
             return err
         }
 
-        var data interface{}
-        json.Unmarshal([]byte(responseTxt), &data)
-
-        res, err := jsonpath.JsonPathLookup(data, "$.entry.content.roles")
+        roles, err := roleHolders(c, PathRoleCreate, "imported_roles", d.Id())
         if err != nil {
             return err
         }
-        t := res.([]interface{})
-        for _, v := range t {
-            roles := fmt.Sprint(v)
-            log.Printf("[DEBUG] role in use: %s", roles)
-            if strings.Contains(roles, d.Id()) {
-                log.Printf("[DEBUG] role in use: %s", d.Id())
-                return fmt.Errorf("Failed to delete a role in use")
-	    }
+
+        if len(users) + len(roles) > 0 && !d.Get("force_detach").(bool) {
+            return fmt.Errorf("Failed to delete role %s still held by users [%s] and imported by roles [%s], set force_detach to remove it from them",
+                d.Id(), strings.Join(entryNames(users), ", "), strings.Join(entryNames(roles), ", "))
+        }
+
+        // Check every user first, so that nothing is detached when one can't be.
+        if stuck := undetachableUsers(users, d.Id()); len(stuck) > 0 {
+            return fmt.Errorf("Failed to delete role %s, it can't be detached from users [%s] whose only role it is or whose roles come from LDAP or SAML",
+                d.Id(), strings.Join(stuck, ", "))
         }
 
+        for _, user := range users {
+            log.Printf("[DEBUG] Detaching role %s from user %s", d.Id(), user.Name)
+            err = detachRole(c, fmt.Sprintf(PathUserSearch, url.QueryEscape(user.Name)), "roles", user, d.Id())
+            if err != nil {
+                return fmt.Errorf("Failed to detach role from user %s: %s", user.Name, err)
+            }
+        }
+
+        for _, role := range roles {
+            log.Printf("[DEBUG] Detaching role %s from role %s", d.Id(), role.Name)
+            err = detachRole(c, fmt.Sprintf(PathRoleSearch, url.QueryEscape(role.Name)), "imported_roles", role, d.Id())
+            if err != nil {
+                return fmt.Errorf("Failed to detach role from role %s: %s", role.Name, err)
+            }
+        }
 
         log.Printf("[DEBUG] Splunk Role Deletion: %s", d.Id())
         err = c.Delete(fmt.Sprintf(PathRoleSearch, url.QueryEscape(d.Id())))
@@ -186,3 +302,71 @@ func resourceSplunkRoleDelete(d *schema.ResourceData, meta interface{}) error {
 
 }
 
+// roleHolders lists the entities of path whose key attribute contains exactly role.
+func roleHolders(c *Client, path, key, role string) (holders []Entry, e error) {
+        b, e := c.Get(path + "?count=0")
+        if e != nil {
+            return
+        }
+
+        f := Feed{}
+        e = json.Unmarshal(b, &f)
+        if e != nil {
+            return
+        }
+
+        for _, entry := range f.Entry {
+            for _, v := range contentStringList(entry.Content, key) {
+                if v == role {
+                    holders = append(holders, entry)
+                    break
+                }
+            }
+        }
+        return
+}
+
+// undetachableUsers lists the users that would be left without any role, and
+// the LDAP and SAML users whose roles are mapped from their groups.
+func undetachableUsers(users []Entry, role string) (names []string) {
+        for _, user := range users {
+            if t := contentString(user.Content, "type"); t != "" && t != "Splunk" {
+                names = append(names, user.Name)
+                continue
+            }
+
+            others := 0
+            for _, v := range contentStringList(user.Content, "roles") {
+                if v != role {
+                    others++
+                }
+            }
+            if others == 0 {
+                names = append(names, user.Name)
+            }
+        }
+        return
+}
+
+// detachRole posts the key attribute of entry back without role.
+func detachRole(c *Client, path, key string, entry Entry, role string) (e error) {
+        r := url.Values{}
+        for _, v := range contentStringList(entry.Content, key) {
+            if v != role {
+                r.Add(key, v)
+            }
+        }
+        if len(r[key]) == 0 {
+            r.Set(key, "")
+        }
+
+        _, e = c.Post(path, r)
+        return
+}
+
+func entryNames(entries []Entry) (names []string) {
+        for _, entry := range entries {
+            names = append(names, entry.Name)
+        }
+        return
+}
@@ -0,0 +1,104 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkSAMLGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkSAMLGroupCreate,
+		Read:   resourceSplunkSAMLGroupRead,
+		Update: resourceSplunkSAMLGroupUpdate,
+		Delete: resourceSplunkSAMLGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceSplunkSAMLGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	name := d.Get("name").(string)
+
+	r := samlGroupParams(d)
+	r.Set("name", name)
+
+	log.Printf("[DEBUG] Splunk SAML Group create: %s", name)
+	_, err := c.Post(PathSAMLGroups, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create SAML group: %s", err)
+	}
+
+	d.SetId(name)
+	return resourceSplunkSAMLGroupRead(d, meta)
+}
+
+func resourceSplunkSAMLGroupRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	roles, err := c.SAMLGroupRolesRead(d.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", d.Id())
+	return d.Set("roles", roles)
+}
+
+func resourceSplunkSAMLGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	log.Printf("[DEBUG] Splunk SAML Group update: %s", d.Id())
+	_, err := c.Post(fmt.Sprintf(PathSAMLGroup, url.PathEscape(d.Id())), samlGroupParams(d))
+	if err != nil {
+		return fmt.Errorf("Failed to update SAML group: %s", err)
+	}
+
+	return resourceSplunkSAMLGroupRead(d, meta)
+}
+
+func resourceSplunkSAMLGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	log.Printf("[INFO] Deleting Splunk SAML Group: %s", d.Id())
+	err := c.Delete(fmt.Sprintf(PathSAMLGroup, url.PathEscape(d.Id())))
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk SAML Group: %s", err)
+	}
+	return nil
+}
+
+func samlGroupParams(d *schema.ResourceData) url.Values {
+	r := url.Values{}
+	for _, role := range d.Get("roles").(*schema.Set).List() {
+		r.Add("roles", role.(string))
+	}
+	return r
+}
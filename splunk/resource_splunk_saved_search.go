@@ -677,125 +677,7 @@ func resourceSplunkSavedSearchRead(d *schema.ResourceData, meta interface{}) err
 	d.SetId(savedSearch.Name)
 
 	d.Set("name", savedSearch.Name)
-	d.Set("search", savedSearch.Configuration.Search)
-	d.Set("cron_schedule", savedSearch.Configuration.CronSchedule)
-	d.Set("description", savedSearch.Configuration.Description)
-	d.Set("disabled", savedSearch.Configuration.Disabled)
-	d.Set("dispatch_earliest_time", savedSearch.Configuration.DispatchEarliestTime)
-	d.Set("dispatch_latest_time", savedSearch.Configuration.DispatchLatestTime)
-	d.Set("dispatch_max_count", savedSearch.Configuration.DispatchMaxCount)
-	d.Set("is_scheduled", savedSearch.Configuration.IsScheduled)
-	d.Set("is_visible", savedSearch.Configuration.IsVisible)
-	d.Set("action_email", savedSearch.Configuration.ActionEmail)
-	d.Set("action_email_auth_username", savedSearch.Configuration.ActionEmailAuthUsername)
-	d.Set("action_email_auth_password", savedSearch.Configuration.ActionEmailAuthPassword)
-	d.Set("action_email_bcc", savedSearch.Configuration.ActionEmailBCC)
-	d.Set("action_email_cc", savedSearch.Configuration.ActionEmailCC)
-	d.Set("action_email_format", savedSearch.Configuration.ActionEmailFormat)
-	d.Set("action_email_from", savedSearch.Configuration.ActionEmailFrom)
-	d.Set("action_email_hostname", savedSearch.Configuration.ActionEmailHostname)
-	d.Set("action_email_inline", savedSearch.Configuration.ActionEmailInline)
-	d.Set("action_email_mailserver", savedSearch.Configuration.ActionEmailMailserver)
-	d.Set("action_email_max_results", savedSearch.Configuration.ActionEmailMaxResults)
-	d.Set("action_email_max_time", savedSearch.Configuration.ActionEmailMaxTime)
-	d.Set("action_email_message_alert", savedSearch.Configuration.ActionEmailMessageAlert)
-	d.Set("action_email_pdfview", savedSearch.Configuration.ActionEmailPDFView)
-	d.Set("action_email_preprocess_results", savedSearch.Configuration.ActionEmailPreprocessResults)
-	d.Set("action_email_report_cid_font_list", savedSearch.Configuration.ActionEmailReportCIDFontList)
-	d.Set("action_email_report_include_splunk_logo", savedSearch.Configuration.ActionEmailReportIncludeSplunkLogo)
-	d.Set("action_email_report_paper_orientation", savedSearch.Configuration.ActionEmailReportPaperOrientation)
-	d.Set("action_email_report_paper_size", savedSearch.Configuration.ActionEmailReportPaperSize)
-	d.Set("action_email_report_server_enabled", savedSearch.Configuration.ActionEmailReportServerEnabled)
-	d.Set("action_email_report_server_url", savedSearch.Configuration.ActionEmailReportServerURL)
-	d.Set("action_email_send_pdf", savedSearch.Configuration.ActionEmailSendPDF)
-	d.Set("action_email_send_results", savedSearch.Configuration.ActionEmailSendResults)
-	d.Set("action_email_subject", savedSearch.Configuration.ActionEmailSubject)
-	d.Set("action_email_to", savedSearch.Configuration.ActionEmailTo)
-	d.Set("action_email_track_alert", savedSearch.Configuration.ActionEmailTrackAlert)
-	d.Set("action_email_ttl", savedSearch.Configuration.ActionEmailTTL)
-	d.Set("action_email_use_ssl", savedSearch.Configuration.ActionEmailUseSSL)
-	d.Set("action_email_use_tls", savedSearch.Configuration.ActionEmailUseTLS)
-	d.Set("action_email_width_sort_columns", savedSearch.Configuration.ActionEmailWidthSortColumns)
-	d.Set("action_populate_lookup", savedSearch.Configuration.ActionPopulateLookup)
-	d.Set("action_populate_lookup_command", savedSearch.Configuration.ActionPopulateLookupCommand)
-	d.Set("action_populate_lookup_dest", savedSearch.Configuration.ActionPopulateLookupDest)
-	d.Set("action_populate_lookup_hostname", savedSearch.Configuration.ActionPopulateLookupHostname)
-	d.Set("action_populate_lookup_max_results", savedSearch.Configuration.ActionPopulateLookupMaxResults)
-	d.Set("action_populate_lookup_max_time", savedSearch.Configuration.ActionPopulateLookupMaxTime)
-	d.Set("action_populate_lookup_track_alert", savedSearch.Configuration.ActionPopulateLookupTrackAlert)
-	d.Set("action_populate_lookup_ttl", savedSearch.Configuration.ActionPopulateLookupTTL)
-	d.Set("action_rss", savedSearch.Configuration.ActionRSS)
-	d.Set("action_rss_command", savedSearch.Configuration.ActionRSSCommand)
-	d.Set("action_rss_hostname", savedSearch.Configuration.ActionRSSHostname)
-	d.Set("action_rss_max_results", savedSearch.Configuration.ActionRSSMaxResults)
-	d.Set("action_rss_max_time", savedSearch.Configuration.ActionRSSMaxTime)
-	d.Set("action_rss_track_alert", savedSearch.Configuration.ActionRSSTrackAlert)
-	d.Set("action_rss_ttl", savedSearch.Configuration.ActionRSSTTL)
-	d.Set("action_slack", savedSearch.Configuration.ActionSlack)
-	d.Set("action_slack_channel", savedSearch.Configuration.ActionSlackChannel)
-	d.Set("action_slack_message", savedSearch.Configuration.ActionSlackMessage)
-	d.Set("action_script", savedSearch.Configuration.ActionScript)
-	d.Set("action_script_command", savedSearch.Configuration.ActionScriptCommand)
-	d.Set("action_script_filename", savedSearch.Configuration.ActionScriptFilename)
-	d.Set("action_script_hostname", savedSearch.Configuration.ActionScriptHostname)
-	d.Set("action_script_max_results", savedSearch.Configuration.ActionScriptMaxResults)
-	d.Set("action_script_max_time", savedSearch.Configuration.ActionScriptMaxTime)
-	d.Set("action_script_track_alert", savedSearch.Configuration.ActionScriptTrackAlert)
-	d.Set("action_script_ttl", savedSearch.Configuration.ActionScriptTTL)
-	d.Set("action_summary_index", savedSearch.Configuration.ActionSummaryIndex)
-	d.Set("action_summary_index_name", savedSearch.Configuration.ActionSummaryIndexName)
-	d.Set("action_summary_index_command", savedSearch.Configuration.ActionSummaryIndexCommand)
-	d.Set("action_summary_index_hostname", savedSearch.Configuration.ActionSummaryIndexHostname)
-	d.Set("action_summary_index_inline", savedSearch.Configuration.ActionSummaryIndexInline)
-	d.Set("action_summary_index_max_results", savedSearch.Configuration.ActionSummaryIndexMaxResults)
-	d.Set("action_summary_index_max_time", savedSearch.Configuration.ActionSummaryIndexMaxTime)
-	d.Set("action_summary_index_track_alert", savedSearch.Configuration.ActionSummaryIndexTrackAlert)
-	d.Set("action_summary_index_ttl", savedSearch.Configuration.ActionSummaryIndexTTL)
-	d.Set("actions", savedSearch.Configuration.Actions)
-	d.Set("alert_digest_mode", savedSearch.Configuration.AlertDigestMode)
-	d.Set("alert_expires", savedSearch.Configuration.AlertExpires)
-	d.Set("alert_severity", savedSearch.Configuration.AlertSeverity)
-	d.Set("alert_suppress", savedSearch.Configuration.AlertSuppress)
-	d.Set("alert_suppress_fields", savedSearch.Configuration.AlertSuppressFields)
-	d.Set("alert_suppress_period", savedSearch.Configuration.AlertSuppressPeriod)
-	d.Set("alert_track", savedSearch.Configuration.AlertTrack)
-	d.Set("alert_comparator", savedSearch.Configuration.AlertComparator)
-	d.Set("alert_condition", savedSearch.Configuration.AlertCondition)
-	d.Set("alert_threshold", savedSearch.Configuration.AlertThreshold)
-	d.Set("alert_type", savedSearch.Configuration.AlertType)
-	d.Set("auto_summarize", savedSearch.Configuration.AutoSummarize)
-	d.Set("auto_summarize_command", savedSearch.Configuration.AutoSummarizeCommand)
-	d.Set("auto_summarize_cron_schedule", savedSearch.Configuration.AutoSummarizeCronSchedule)
-	d.Set("auto_summarize_dispatch_earliest_time", savedSearch.Configuration.AutoSummarizeDispatchEarliestTime)
-	d.Set("auto_summarize_dispatch_latest_time", savedSearch.Configuration.AutoSummarizeDispatchLatestTime)
-	d.Set("auto_summarize_dispatch_time_format", savedSearch.Configuration.AutoSummarizeDispatchTimeFormat)
-	d.Set("auto_summarize_dispatch_ttl", savedSearch.Configuration.AutoSummarizeDispatchTTL)
-	d.Set("auto_summarize_max_disabled_buckets", savedSearch.Configuration.AutoSummarizeMaxDisabledBuckets)
-	d.Set("auto_summarize_max_summary_ratio", savedSearch.Configuration.AutoSummarizeMaxSummaryRatio)
-	d.Set("auto_summarize_max_summary_size", savedSearch.Configuration.AutoSummarizeMaxSummarySize)
-	d.Set("auto_summarize_max_time", savedSearch.Configuration.AutoSummarizeMaxTime)
-	d.Set("auto_summarize_suspend_period", savedSearch.Configuration.AutoSummarizeSuspendPeriod)
-	d.Set("auto_summarize_timespan", savedSearch.Configuration.AutoSummarizeTimespan)
-	d.Set("dispatch_buckets", savedSearch.Configuration.DispatchBuckets)
-	d.Set("dispatch_indexed_realtime", savedSearch.Configuration.DispatchIndexedRealtime)
-	d.Set("dispatch_lookups", savedSearch.Configuration.DispatchLookups)
-	d.Set("dispatch_max_time", savedSearch.Configuration.DispatchMaxTime)
-	d.Set("dispatch_reduce_freq", savedSearch.Configuration.DispatchReduceFreq)
-	d.Set("dispatch_rt_backfill", savedSearch.Configuration.DispatchRtBackfill)
-	d.Set("dispatch_spawn_process", savedSearch.Configuration.DispatchSpawnProcess)
-	d.Set("dispatch_time_format", savedSearch.Configuration.DispatchTimeFormat)
-	d.Set("dispatch_ttl", savedSearch.Configuration.DispatchTTL)
-	d.Set("dispatch_earliest_time", savedSearch.Configuration.DispatchEarliestTime)
-	d.Set("dispatch_latest_time", savedSearch.Configuration.DispatchLatestTime)
-	d.Set("dispatch_max_count", savedSearch.Configuration.DispatchMaxCount)
-	d.Set("displayview", savedSearch.Configuration.DisplayView)
-	d.Set("max_concurrent", savedSearch.Configuration.MaxConcurrent)
-	d.Set("realtime_schedule", savedSearch.Configuration.RealtimeSchedule)
-	d.Set("request_ui_dispatch_app", savedSearch.Configuration.RequestUIDispatchApp)
-	d.Set("request_ui_dispatch_view", savedSearch.Configuration.RequestUIDispatchView)
-	d.Set("restart_on_searchpeer_add", savedSearch.Configuration.RestartOnSearchPeerAdd)
-	d.Set("run_on_startup", savedSearch.Configuration.RunOnStartup)
-	d.Set("vsid", savedSearch.Configuration.VSID)
+	setSavedSearchConfiguration(d, &savedSearch.Configuration)
 
 	f := flattenAcl(&savedSearch.ACL)
 	log.Printf("[DEBUG] Flattened ACL: %#v", f)
@@ -804,6 +686,130 @@ func resourceSplunkSavedSearchRead(d *schema.ResourceData, meta interface{}) err
 	return err
 }
 
+// setSavedSearchConfiguration sets the arguments read from a saved search
+// configuration, shared with the saved search data sources.
+func setSavedSearchConfiguration(d *schema.ResourceData, s *SavedSearchConfiguration) {
+	d.Set("search", s.Search)
+	d.Set("cron_schedule", s.CronSchedule)
+	d.Set("description", s.Description)
+	d.Set("disabled", s.Disabled)
+	d.Set("dispatch_earliest_time", s.DispatchEarliestTime)
+	d.Set("dispatch_latest_time", s.DispatchLatestTime)
+	d.Set("dispatch_max_count", s.DispatchMaxCount)
+	d.Set("is_scheduled", s.IsScheduled)
+	d.Set("is_visible", s.IsVisible)
+	d.Set("action_email", s.ActionEmail)
+	d.Set("action_email_auth_username", s.ActionEmailAuthUsername)
+	d.Set("action_email_auth_password", s.ActionEmailAuthPassword)
+	d.Set("action_email_bcc", s.ActionEmailBCC)
+	d.Set("action_email_cc", s.ActionEmailCC)
+	d.Set("action_email_format", s.ActionEmailFormat)
+	d.Set("action_email_from", s.ActionEmailFrom)
+	d.Set("action_email_hostname", s.ActionEmailHostname)
+	d.Set("action_email_inline", s.ActionEmailInline)
+	d.Set("action_email_mailserver", s.ActionEmailMailserver)
+	d.Set("action_email_max_results", s.ActionEmailMaxResults)
+	d.Set("action_email_max_time", s.ActionEmailMaxTime)
+	d.Set("action_email_message_alert", s.ActionEmailMessageAlert)
+	d.Set("action_email_pdfview", s.ActionEmailPDFView)
+	d.Set("action_email_preprocess_results", s.ActionEmailPreprocessResults)
+	d.Set("action_email_report_cid_font_list", s.ActionEmailReportCIDFontList)
+	d.Set("action_email_report_include_splunk_logo", s.ActionEmailReportIncludeSplunkLogo)
+	d.Set("action_email_report_paper_orientation", s.ActionEmailReportPaperOrientation)
+	d.Set("action_email_report_paper_size", s.ActionEmailReportPaperSize)
+	d.Set("action_email_report_server_enabled", s.ActionEmailReportServerEnabled)
+	d.Set("action_email_report_server_url", s.ActionEmailReportServerURL)
+	d.Set("action_email_send_pdf", s.ActionEmailSendPDF)
+	d.Set("action_email_send_results", s.ActionEmailSendResults)
+	d.Set("action_email_subject", s.ActionEmailSubject)
+	d.Set("action_email_to", s.ActionEmailTo)
+	d.Set("action_email_track_alert", s.ActionEmailTrackAlert)
+	d.Set("action_email_ttl", s.ActionEmailTTL)
+	d.Set("action_email_use_ssl", s.ActionEmailUseSSL)
+	d.Set("action_email_use_tls", s.ActionEmailUseTLS)
+	d.Set("action_email_width_sort_columns", s.ActionEmailWidthSortColumns)
+	d.Set("action_populate_lookup", s.ActionPopulateLookup)
+	d.Set("action_populate_lookup_command", s.ActionPopulateLookupCommand)
+	d.Set("action_populate_lookup_dest", s.ActionPopulateLookupDest)
+	d.Set("action_populate_lookup_hostname", s.ActionPopulateLookupHostname)
+	d.Set("action_populate_lookup_max_results", s.ActionPopulateLookupMaxResults)
+	d.Set("action_populate_lookup_max_time", s.ActionPopulateLookupMaxTime)
+	d.Set("action_populate_lookup_track_alert", s.ActionPopulateLookupTrackAlert)
+	d.Set("action_populate_lookup_ttl", s.ActionPopulateLookupTTL)
+	d.Set("action_rss", s.ActionRSS)
+	d.Set("action_rss_command", s.ActionRSSCommand)
+	d.Set("action_rss_hostname", s.ActionRSSHostname)
+	d.Set("action_rss_max_results", s.ActionRSSMaxResults)
+	d.Set("action_rss_max_time", s.ActionRSSMaxTime)
+	d.Set("action_rss_track_alert", s.ActionRSSTrackAlert)
+	d.Set("action_rss_ttl", s.ActionRSSTTL)
+	d.Set("action_slack", s.ActionSlack)
+	d.Set("action_slack_channel", s.ActionSlackChannel)
+	d.Set("action_slack_message", s.ActionSlackMessage)
+	d.Set("action_script", s.ActionScript)
+	d.Set("action_script_command", s.ActionScriptCommand)
+	d.Set("action_script_filename", s.ActionScriptFilename)
+	d.Set("action_script_hostname", s.ActionScriptHostname)
+	d.Set("action_script_max_results", s.ActionScriptMaxResults)
+	d.Set("action_script_max_time", s.ActionScriptMaxTime)
+	d.Set("action_script_track_alert", s.ActionScriptTrackAlert)
+	d.Set("action_script_ttl", s.ActionScriptTTL)
+	d.Set("action_summary_index", s.ActionSummaryIndex)
+	d.Set("action_summary_index_name", s.ActionSummaryIndexName)
+	d.Set("action_summary_index_command", s.ActionSummaryIndexCommand)
+	d.Set("action_summary_index_hostname", s.ActionSummaryIndexHostname)
+	d.Set("action_summary_index_inline", s.ActionSummaryIndexInline)
+	d.Set("action_summary_index_max_results", s.ActionSummaryIndexMaxResults)
+	d.Set("action_summary_index_max_time", s.ActionSummaryIndexMaxTime)
+	d.Set("action_summary_index_track_alert", s.ActionSummaryIndexTrackAlert)
+	d.Set("action_summary_index_ttl", s.ActionSummaryIndexTTL)
+	d.Set("actions", s.Actions)
+	d.Set("alert_digest_mode", s.AlertDigestMode)
+	d.Set("alert_expires", s.AlertExpires)
+	d.Set("alert_severity", s.AlertSeverity)
+	d.Set("alert_suppress", s.AlertSuppress)
+	d.Set("alert_suppress_fields", s.AlertSuppressFields)
+	d.Set("alert_suppress_period", s.AlertSuppressPeriod)
+	d.Set("alert_track", s.AlertTrack)
+	d.Set("alert_comparator", s.AlertComparator)
+	d.Set("alert_condition", s.AlertCondition)
+	d.Set("alert_threshold", s.AlertThreshold)
+	d.Set("alert_type", s.AlertType)
+	d.Set("auto_summarize", s.AutoSummarize)
+	d.Set("auto_summarize_command", s.AutoSummarizeCommand)
+	d.Set("auto_summarize_cron_schedule", s.AutoSummarizeCronSchedule)
+	d.Set("auto_summarize_dispatch_earliest_time", s.AutoSummarizeDispatchEarliestTime)
+	d.Set("auto_summarize_dispatch_latest_time", s.AutoSummarizeDispatchLatestTime)
+	d.Set("auto_summarize_dispatch_time_format", s.AutoSummarizeDispatchTimeFormat)
+	d.Set("auto_summarize_dispatch_ttl", s.AutoSummarizeDispatchTTL)
+	d.Set("auto_summarize_max_disabled_buckets", s.AutoSummarizeMaxDisabledBuckets)
+	d.Set("auto_summarize_max_summary_ratio", s.AutoSummarizeMaxSummaryRatio)
+	d.Set("auto_summarize_max_summary_size", s.AutoSummarizeMaxSummarySize)
+	d.Set("auto_summarize_max_time", s.AutoSummarizeMaxTime)
+	d.Set("auto_summarize_suspend_period", s.AutoSummarizeSuspendPeriod)
+	d.Set("auto_summarize_timespan", s.AutoSummarizeTimespan)
+	d.Set("dispatch_buckets", s.DispatchBuckets)
+	d.Set("dispatch_indexed_realtime", s.DispatchIndexedRealtime)
+	d.Set("dispatch_lookups", s.DispatchLookups)
+	d.Set("dispatch_max_time", s.DispatchMaxTime)
+	d.Set("dispatch_reduce_freq", s.DispatchReduceFreq)
+	d.Set("dispatch_rt_backfill", s.DispatchRtBackfill)
+	d.Set("dispatch_spawn_process", s.DispatchSpawnProcess)
+	d.Set("dispatch_time_format", s.DispatchTimeFormat)
+	d.Set("dispatch_ttl", s.DispatchTTL)
+	d.Set("dispatch_earliest_time", s.DispatchEarliestTime)
+	d.Set("dispatch_latest_time", s.DispatchLatestTime)
+	d.Set("dispatch_max_count", s.DispatchMaxCount)
+	d.Set("displayview", s.DisplayView)
+	d.Set("max_concurrent", s.MaxConcurrent)
+	d.Set("realtime_schedule", s.RealtimeSchedule)
+	d.Set("request_ui_dispatch_app", s.RequestUIDispatchApp)
+	d.Set("request_ui_dispatch_view", s.RequestUIDispatchView)
+	d.Set("restart_on_searchpeer_add", s.RestartOnSearchPeerAdd)
+	d.Set("run_on_startup", s.RunOnStartup)
+	d.Set("vsid", s.VSID)
+}
+
 func flattenAcl(a *ACL) []interface{} {
 	m := make(map[string]interface{})
 
@@ -0,0 +1,253 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkSourcetype() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkSourcetypeCreate,
+		Read:   resourceSplunkSourcetypeRead,
+		Update: resourceSplunkSourcetypeUpdate,
+		Delete: resourceSplunkSourcetypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"pulldown_type": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"should_linemerge": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"line_breaker": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"time_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"time_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"max_timestamp_lookahead": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"truncate": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"charset": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"extra": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+// Props keys behind the first class sourcetype arguments.
+var sourcetypeStringKeys = map[string]string{
+	"description":  "description",
+	"category":     "category",
+	"line_breaker": "LINE_BREAKER",
+	"time_prefix":  "TIME_PREFIX",
+	"time_format":  "TIME_FORMAT",
+	"charset":      "CHARSET",
+}
+
+func resourceSplunkSourcetypeCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	name := d.Get("name").(string)
+
+	r := sourcetypeParams(d)
+	r.Set("name", name)
+
+	log.Printf("[DEBUG] Splunk Sourcetype create: %s/%s", app, name)
+	_, err := c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathConfProps, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create sourcetype: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, name))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathConfProps, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update sourcetype ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkSourcetypeRead(d, meta)
+}
+
+func resourceSplunkSourcetypeRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathConfProps, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("app", app)
+	for k, key := range sourcetypeStringKeys {
+		d.Set(k, contentString(o.Content, key))
+	}
+	d.Set("pulldown_type", contentBool(o.Content, "pulldown_type"))
+	d.Set("should_linemerge", contentBool(o.Content, "SHOULD_LINEMERGE"))
+	d.Set("max_timestamp_lookahead", contentInt(o.Content, "MAX_TIMESTAMP_LOOKAHEAD"))
+	d.Set("truncate", contentInt(o.Content, "TRUNCATE"))
+
+	// The stanza holds every inherited default, only track the declared keys.
+	extra := make(map[string]string)
+	for k := range d.Get("extra").(map[string]interface{}) {
+		if _, ok := o.Content[k]; ok {
+			extra[k] = contentString(o.Content, k)
+		}
+	}
+	d.Set("extra", extra)
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkSourcetypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk Sourcetype update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathConfProps, name, sourcetypeParams(d))
+	if err != nil {
+		return fmt.Errorf("Failed to update sourcetype: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathConfProps, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update sourcetype ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkSourcetypeRead(d, meta)
+}
+
+func resourceSplunkSourcetypeDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Sourcetype: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathConfProps, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Sourcetype: %s", err)
+	}
+	return nil
+}
+
+// sourcetypeParams only posts the configured settings on create, and the
+// changed ones on update, so that the Splunk defaults read back into the
+// computed attributes aren't copied to the local props.conf.
+func sourcetypeParams(d *schema.ResourceData) url.Values {
+	r := url.Values{}
+	setPrefixMapParams(d, r, "extra", "")
+
+	set := func(k string) bool {
+		if d.Id() == "" {
+			_, ok := d.GetOkExists(k)
+			return ok
+		}
+		return d.HasChange(k)
+	}
+
+	for k, key := range sourcetypeStringKeys {
+		if set(k) {
+			r.Set(key, d.Get(k).(string))
+		}
+	}
+	if set("pulldown_type") {
+		r.Set("pulldown_type", strconv.FormatBool(d.Get("pulldown_type").(bool)))
+	}
+	if set("should_linemerge") {
+		r.Set("SHOULD_LINEMERGE", strconv.FormatBool(d.Get("should_linemerge").(bool)))
+	}
+	if set("max_timestamp_lookahead") {
+		r.Set("MAX_TIMESTAMP_LOOKAHEAD", strconv.Itoa(d.Get("max_timestamp_lookahead").(int)))
+	}
+	if set("truncate") {
+		r.Set("TRUNCATE", strconv.Itoa(d.Get("truncate").(int)))
+	}
+	return r
+}
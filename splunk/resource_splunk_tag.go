@@ -0,0 +1,176 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkTagCreate,
+		Read:   resourceSplunkTagRead,
+		Update: resourceSplunkTagUpdate,
+		Delete: resourceSplunkTagDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"field": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"value": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: schema.HashString,
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkTagCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	name := fmt.Sprintf("%s=%s", d.Get("field").(string), d.Get("value").(string))
+
+	r := tagParams(d)
+	r.Set("name", name)
+
+	log.Printf("[DEBUG] Splunk Tag create: %s/%s", app, name)
+	_, err := c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathFieldValueTags, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create tag: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, name))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathFieldValueTags, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update tag ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkTagRead(d, meta)
+}
+
+func resourceSplunkTagRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathFieldValueTags, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	fv := strings.SplitN(name, "=", 2)
+	if len(fv) != 2 {
+		return fmt.Errorf("Invalid tag ID %q, expected app/field=value", d.Id())
+	}
+
+	var tags []string
+	for k, v := range contentPrefixMap(o.Content, "tag.") {
+		if v == "enabled" {
+			tags = append(tags, k)
+		}
+	}
+
+	d.Set("app", app)
+	d.Set("field", fv[0])
+	d.Set("value", fv[1])
+	d.Set("tags", tags)
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk Tag update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathFieldValueTags, name, tagParams(d))
+	if err != nil {
+		return fmt.Errorf("Failed to update tag: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathFieldValueTags, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update tag ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkTagRead(d, meta)
+}
+
+func resourceSplunkTagDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Tag: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathFieldValueTags, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Tag: %s", err)
+	}
+	return nil
+}
+
+// tagParams enables the configured tags and disables the ones removed since
+// the last apply, as fvtags entries can't drop a single key.
+func tagParams(d *schema.ResourceData) url.Values {
+	r := url.Values{}
+
+	o, n := d.GetChange("tags")
+	for _, t := range o.(*schema.Set).Difference(n.(*schema.Set)).List() {
+		r.Set("tag."+t.(string), "disabled")
+	}
+	for _, t := range n.(*schema.Set).List() {
+		r.Set("tag."+t.(string), "enabled")
+	}
+	return r
+}
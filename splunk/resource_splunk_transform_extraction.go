@@ -0,0 +1,195 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSplunkTransformExtraction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkTransformExtractionCreate,
+		Read:   resourceSplunkTransformExtractionRead,
+		Update: resourceSplunkTransformExtractionUpdate,
+		Delete: resourceSplunkTransformExtractionDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"source_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "_raw",
+			},
+			"delims": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"mv_add": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"clean_keys": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+func resourceSplunkTransformExtractionCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	name := d.Get("name").(string)
+
+	if d.Get("regex").(string) == "" && d.Get("delims").(string) == "" {
+		return fmt.Errorf("One of regex or delims must be set")
+	}
+
+	r := transformExtractionParams(d)
+	r.Set("name", name)
+
+	log.Printf("[DEBUG] Splunk Transform Extraction create: %s/%s", app, name)
+	_, err := c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathTransformsExtractions, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create transform extraction: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, name))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathTransformsExtractions, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update transform extraction ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkTransformExtractionRead(d, meta)
+}
+
+func resourceSplunkTransformExtractionRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathTransformsExtractions, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("app", app)
+	d.Set("regex", contentString(o.Content, "REGEX"))
+	d.Set("format", contentString(o.Content, "FORMAT"))
+	d.Set("source_key", contentString(o.Content, "SOURCE_KEY"))
+	d.Set("delims", contentString(o.Content, "DELIMS"))
+	d.Set("fields", contentStringList(o.Content, "FIELDS"))
+	d.Set("mv_add", contentBool(o.Content, "MV_ADD"))
+	d.Set("clean_keys", contentBool(o.Content, "CLEAN_KEYS"))
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkTransformExtractionUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk Transform Extraction update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathTransformsExtractions, name, transformExtractionParams(d))
+	if err != nil {
+		return fmt.Errorf("Failed to update transform extraction: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathTransformsExtractions, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update transform extraction ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkTransformExtractionRead(d, meta)
+}
+
+func resourceSplunkTransformExtractionDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Transform Extraction: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathTransformsExtractions, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Transform Extraction: %s", err)
+	}
+	return nil
+}
+
+func transformExtractionParams(d *schema.ResourceData) url.Values {
+	r := url.Values{}
+	r.Set("REGEX", d.Get("regex").(string))
+	if v, ok := d.GetOk("format"); ok {
+		r.Set("FORMAT", v.(string))
+	}
+	r.Set("SOURCE_KEY", d.Get("source_key").(string))
+	r.Set("DELIMS", d.Get("delims").(string))
+	r.Set("FIELDS", strings.Join(stringArrayFromInterface(d.Get("fields").([]interface{})), ","))
+	r.Set("MV_ADD", strconv.FormatBool(d.Get("mv_add").(bool)))
+	r.Set("CLEAN_KEYS", strconv.FormatBool(d.Get("clean_keys").(bool)))
+	return r
+}
@@ -7,6 +7,7 @@ import (
     "fmt"
     "encoding/json"
     "net/url"
+    "strconv"
 )
 
 func resourceSplunkUser() *schema.Resource {
@@ -18,6 +19,8 @@ func resourceSplunkUser() *schema.Resource {
                 Importer: &schema.ResourceImporter{
                         State: schema.ImportStatePassthrough,
                 },
+                SchemaVersion: 1,
+                MigrateState:  resourceSplunkUserMigrateState,
 
                 Schema: map[string]*schema.Schema{
                         "name": {
@@ -26,24 +29,61 @@ func resourceSplunkUser() *schema.Resource {
                                 Required: true,
                         },
                         "password": {
-                                Type:     schema.TypeString,
-                                Required: true,
+                                Type:      schema.TypeString,
+                                Optional:  true,
+                                Sensitive: true,
+                                StateFunc: hashSecret,
                         },
-                        "real_name": {
-                                Type:     schema.TypeString,
+                        "force_change_pass": {
+                                Type:     schema.TypeBool,
                                 Optional: true,
+                                Default:  true,
+                        },
+                        "real_name": {
+                                Type:             schema.TypeString,
+                                Optional:         true,
+                                DiffSuppressFunc: suppressExternalUserDiff,
                         },
                         "email": {
-                                Type:     schema.TypeString,
-                                Optional: true,
+                                Type:             schema.TypeString,
+                                Optional:         true,
+                                DiffSuppressFunc: suppressExternalUserDiff,
                         },
                         "roles": {
 				Type:     schema.TypeList,
                                 Optional: true,
+                                DiffSuppressFunc: suppressExternalUserDiff,
                                 Elem: &schema.Schema{
                                   Type:         schema.TypeString,
                                 },
                         },
+                        "default_app": {
+                                Type:     schema.TypeString,
+                                Optional: true,
+                                Computed: true,
+                        },
+                        "tz": {
+                                Type:     schema.TypeString,
+                                Optional: true,
+                                Computed: true,
+                        },
+                        "restart_background_jobs": {
+                                Type:     schema.TypeBool,
+                                Optional: true,
+                                Default:  true,
+                        },
+                        "on_destroy_reassign_to": {
+                                Type:     schema.TypeString,
+                                Optional: true,
+                        },
+                        "locked_out": {
+                                Type:     schema.TypeBool,
+                                Computed: true,
+                        },
+                        "type": {
+                                Type:     schema.TypeString,
+                                Computed: true,
+                        },
                 },
         }
 }
@@ -51,12 +91,18 @@ func resourceSplunkUser() *schema.Resource {
 func resourceSplunkUserCreate(d *schema.ResourceData, meta interface{}) error {
         c := meta.(*Client)
 
+        password, ok := d.GetOk("password")
+        if !ok {
+            return fmt.Errorf("Failed to create user: a password is required to create a user")
+        }
+
 	r := url.Values{}
         r.Set("name",     d.Get("name").(string))
-        r.Set("password", d.Get("password").(string))
+        r.Set("password", password.(string))
         r.Set("email",    d.Get("email").(string))
         r.Set("realname", d.Get("real_name").(string))
-        r.Set("force-change-pass", "true")
+        r.Set("force-change-pass", strconv.FormatBool(d.Get("force_change_pass").(bool)))
+        userPreferenceParams(d, r)
 
         for _, element := range d.Get("roles").([]interface{}) {
             r.Add("roles", element.(string))
@@ -114,6 +160,17 @@ func resourceSplunkUserRead(d *schema.ResourceData, meta interface{}) error {
         }
         d.Set("roles", s)
 
+        res, err = jsonpath.JsonPathLookup(data, "$.entry[0].content")
+        if err != nil {
+            return err
+        }
+        content := res.(map[string]interface{})
+        d.Set("default_app", contentString(content, "defaultApp"))
+        d.Set("tz", contentString(content, "tz"))
+        d.Set("restart_background_jobs", contentBool(content, "restart_background_jobs"))
+        d.Set("locked_out", contentBool(content, "locked-out"))
+        d.Set("type", contentString(content, "type"))
+
         log.Printf("[DEBUG] Splunk User Read: %s", d.Get("name").(string))
 
         return err
@@ -123,16 +180,25 @@ func resourceSplunkUserUpdate(d *schema.ResourceData, meta interface{}) error {
         c := meta.(*Client)
 
 	r := url.Values{}
-        //r.Set("name",     d.Get("name").(string))
-        r.Set("email",    d.Get("email").(string))
-        r.Set("realname", d.Get("real_name").(string))
-        if d.HasChange("password") {
-            r.Set("password", d.Get("password").(string))
-            r.Set("force-change-pass", "true")
-        }
-
-        for _, element := range d.Get("roles").([]interface{}) {
-            r.Add("roles", element.(string))
+        userPreferenceParams(d, r)
+
+        // Users authenticated by LDAP or SAML get their name, email and roles
+        // from the identity provider, only their preferences can be changed.
+        if t := d.Get("type").(string); t != "" && t != "Splunk" {
+            log.Printf("[WARN] Splunk User %s is a %s user, only updating its preferences", d.Id(), t)
+        } else {
+            //r.Set("name",     d.Get("name").(string))
+            r.Set("email",    d.Get("email").(string))
+            r.Set("realname", d.Get("real_name").(string))
+            // Users imported or adopted without a password keep their own
+            if password, ok := d.GetOk("password"); ok && d.HasChange("password") {
+                r.Set("password", password.(string))
+                r.Set("force-change-pass", strconv.FormatBool(d.Get("force_change_pass").(bool)))
+            }
+
+            for _, element := range d.Get("roles").([]interface{}) {
+                r.Add("roles", element.(string))
+            }
         }
 
         log.Printf("[DEBUG] Splunk User Update: %s", d.Get("name").(string))
@@ -147,6 +213,14 @@ func resourceSplunkUserUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceSplunkUserDelete(d *schema.ResourceData, meta interface{}) error {
         c := meta.(*Client)
 
+        if to, ok := d.GetOk("on_destroy_reassign_to"); ok {
+            log.Printf("[DEBUG] Reassigning knowledge objects of %s to %s", d.Id(), to.(string))
+            err := c.KnowledgeObjectsReassign(d.Id(), to.(string))
+            if err != nil {
+                return fmt.Errorf("Failed to reassign knowledge objects of user %s: %s", d.Id(), err)
+            }
+        }
+
         log.Printf("[DEBUG] Splunk User Deletion: %s", d.Id())
         err := c.Delete(fmt.Sprintf(PathUserSearch, url.QueryEscape(d.Id())))
 
@@ -154,3 +228,19 @@ func resourceSplunkUserDelete(d *schema.ResourceData, meta interface{}) error {
 
 }
 
+func userPreferenceParams(d *schema.ResourceData, r url.Values) {
+        if v, ok := d.GetOk("default_app"); ok {
+            r.Set("defaultApp", v.(string))
+        }
+        if v, ok := d.GetOk("tz"); ok {
+            r.Set("tz", v.(string))
+        }
+        r.Set("restart_background_jobs", strconv.FormatBool(d.Get("restart_background_jobs").(bool)))
+}
+
+// suppressExternalUserDiff ignores the attributes owned by the identity
+// provider of LDAP and SAML users, as they cannot be updated.
+func suppressExternalUserDiff(k, old, new string, d *schema.ResourceData) bool {
+        t := d.Get("type").(string)
+        return t != "" && t != "Splunk"
+}
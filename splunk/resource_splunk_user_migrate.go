@@ -0,0 +1,32 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// resourceSplunkUserMigrateState upgrades the states written before the
+// password was hashed, which still hold it in cleartext.
+func resourceSplunkUserMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found Splunk User State v0; migrating to v1")
+		return migrateSplunkUserStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func migrateSplunkUserStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() || is.Attributes == nil {
+		log.Println("[DEBUG] Empty Splunk User State; nothing to migrate.")
+		return is, nil
+	}
+
+	if password, ok := is.Attributes["password"]; ok {
+		is.Attributes["password"] = hashSecret(password)
+	}
+	return is, nil
+}
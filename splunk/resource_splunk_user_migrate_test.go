@@ -0,0 +1,44 @@
+package splunk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestSplunkUserMigrateState(t *testing.T) {
+	cases := map[string]struct {
+		Attributes map[string]string
+		Expected   string
+	}{
+		"cleartext password": {
+			Attributes: map[string]string{"password": "changeme"},
+			Expected:   hashSecret("changeme"),
+		},
+		"empty password": {
+			Attributes: map[string]string{"password": ""},
+			Expected:   "",
+		},
+	}
+
+	for name, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         "admin",
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceSplunkUserMigrateState(0, is, nil)
+		if err != nil {
+			t.Fatalf("%s: err: %s", name, err)
+		}
+		if is.Attributes["password"] != tc.Expected {
+			t.Fatalf("%s: expected password %q, got %q", name, tc.Expected, is.Attributes["password"])
+		}
+	}
+}
+
+func TestSplunkUserMigrateState_empty(t *testing.T) {
+	var is *terraform.InstanceState
+	if _, err := resourceSplunkUserMigrateState(0, is, nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
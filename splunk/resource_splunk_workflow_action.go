@@ -0,0 +1,264 @@
+package splunk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceSplunkWorkflowAction() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSplunkWorkflowActionCreate,
+		Read:   resourceSplunkWorkflowActionRead,
+		Update: resourceSplunkWorkflowActionUpdate,
+		Delete: resourceSplunkWorkflowActionDelete,
+		Importer: &schema.ResourceImporter{
+			State: importKnowledgeObjectState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"app": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "search",
+			},
+			"owner": {
+				ForceNew: true,
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "nobody",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"link", "search"}, false),
+			},
+			"label": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"display_location": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "both",
+				ValidateFunc: validation.StringInSlice([]string{"both", "field_menu", "event_menu"}, false),
+			},
+			"fields": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"eventtypes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"link_uri": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"link_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "get",
+				ValidateFunc: validation.StringInSlice([]string{"get", "post"}, false),
+			},
+			"link_target": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "blank",
+				ValidateFunc: validation.StringInSlice([]string{"blank", "self"}, false),
+			},
+			"search_string": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"search_app": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"search_view": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"search_target": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "blank",
+				ValidateFunc: validation.StringInSlice([]string{"blank", "self"}, false),
+			},
+			"search_earliest": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"search_latest": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"search_preserve_timerange": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"acl": aclSchema(),
+		},
+	}
+}
+
+// Workflow action keys behind the string arguments.
+var workflowActionStringKeys = map[string]string{
+	"type":             "type",
+	"label":            "label",
+	"display_location": "display_location",
+	"link_uri":         "link.uri",
+	"link_method":      "link.method",
+	"link_target":      "link.target",
+	"search_string":    "search.search_string",
+	"search_app":       "search.app",
+	"search_view":      "search.view",
+	"search_target":    "search.target",
+	"search_earliest":  "search.earliest",
+	"search_latest":    "search.latest",
+}
+
+func resourceSplunkWorkflowActionCreate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app := d.Get("app").(string)
+	name := d.Get("name").(string)
+
+	r, err := workflowActionParams(d)
+	if err != nil {
+		return err
+	}
+	r.Set("name", name)
+
+	log.Printf("[DEBUG] Splunk Workflow Action create: %s/%s", app, name)
+	_, err = c.KnowledgeObjectCreate(d.Get("owner").(string), app, PathWorkflowActions, r)
+	if err != nil {
+		return fmt.Errorf("Failed to create workflow action: %s", err)
+	}
+
+	d.SetId(knowledgeObjectID(app, name))
+
+	if acl := aclFromResourceData(d); acl != nil {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathWorkflowActions, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update workflow action ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkWorkflowActionRead(d, meta)
+}
+
+func resourceSplunkWorkflowActionRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	o, err := c.KnowledgeObjectRead("-", app, PathWorkflowActions, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[WARN] Removing resource from state because it's not found in API")
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", name)
+	d.Set("app", app)
+	for k, key := range workflowActionStringKeys {
+		d.Set(k, contentString(o.Content, key))
+	}
+	d.Set("fields", contentStringList(o.Content, "fields"))
+	d.Set("eventtypes", contentStringList(o.Content, "eventtypes"))
+	d.Set("search_preserve_timerange", contentBool(o.Content, "search.preserve_timerange"))
+
+	return d.Set("acl", flattenAcl(&o.ACL))
+}
+
+func resourceSplunkWorkflowActionUpdate(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	r, err := workflowActionParams(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Splunk Workflow Action update: %s", d.Id())
+	_, err = c.KnowledgeObjectUpdate("-", app, PathWorkflowActions, name, r)
+	if err != nil {
+		return fmt.Errorf("Failed to update workflow action: %s", err)
+	}
+
+	if acl := aclFromResourceData(d); acl != nil && d.HasChange("acl") {
+		err = c.KnowledgeObjectACLUpdate("-", app, PathWorkflowActions, name, acl)
+		if err != nil {
+			return fmt.Errorf("Failed to update workflow action ACL: %s", err)
+		}
+	}
+
+	return resourceSplunkWorkflowActionRead(d, meta)
+}
+
+func resourceSplunkWorkflowActionDelete(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Client)
+
+	app, name, err := parseKnowledgeObjectID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Splunk Workflow Action: %s", d.Id())
+	err = c.KnowledgeObjectDelete("-", app, PathWorkflowActions, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Splunk Workflow Action: %s", err)
+	}
+	return nil
+}
+
+func workflowActionParams(d *schema.ResourceData) (url.Values, error) {
+	switch d.Get("type").(string) {
+	case "link":
+		if d.Get("link_uri").(string) == "" {
+			return nil, fmt.Errorf("link_uri must be set for link workflow actions")
+		}
+	case "search":
+		if d.Get("search_string").(string) == "" {
+			return nil, fmt.Errorf("search_string must be set for search workflow actions")
+		}
+	}
+
+	r := url.Values{}
+	for k, key := range workflowActionStringKeys {
+		r.Set(key, d.Get(k).(string))
+	}
+	r.Set("fields", strings.Join(stringArrayFromInterface(d.Get("fields").([]interface{})), ","))
+	r.Set("eventtypes", strings.Join(stringArrayFromInterface(d.Get("eventtypes").([]interface{})), ","))
+	r.Set("search.preserve_timerange", strconv.FormatBool(d.Get("search_preserve_timerange").(bool)))
+	return r, nil
+}
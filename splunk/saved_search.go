@@ -416,6 +416,46 @@ func (c *Client) SavedSearchRead(name string) (r SavedSearch, e error) {
 	return
 }
 
+// SavedSearchReadNamespaced returns the saved search named name in the
+// owner and app namespace, either of which may be the "-" wildcard.
+func (c *Client) SavedSearchReadNamespaced(owner, app, name string) (r SavedSearch, e error) {
+	f, e := c.SavedSearchList(owner, app, url.PathEscape(name), nil)
+	if e != nil {
+		return
+	}
+
+	// a wildcard read also returns the saved searches shared from other apps
+	acls := make([]ACL, len(f.Entry))
+	for i, entry := range f.Entry {
+		acls[i] = entry.ACL
+	}
+	i, e := appEntryIndex(acls, app)
+	if e != nil {
+		return
+	}
+	return f.Entry[i], nil
+}
+
+// SavedSearchList lists the saved searches at path in the owner and app
+// namespace, filtered by the params such as search.
+func (c *Client) SavedSearchList(owner, app, path string, params url.Values) (f SavedSearchFeed, e error) {
+	u := namespacedPath(owner, app, PathSavedSearches)
+	if path != "" {
+		u += "/" + path
+	}
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+
+	b, e := c.Get(u)
+	if e != nil {
+		return
+	}
+
+	e = json.Unmarshal(b, &f)
+	return
+}
+
 // SavedSearchDelete deletes a Saved Search from Splunk
 func (c *Client) SavedSearchDelete(name string) (e error) {
 	link, e := c.SavedSearchLink(name, "remove")
@@ -0,0 +1,16 @@
+package splunk
+
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+// hashSecret is the StateFunc of write-only secrets: only a digest is kept in
+// the state, which is still enough to detect a change in configuration.
+func hashSecret(v interface{}) string {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(s)))
+}
@@ -0,0 +1,60 @@
+package splunk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// TokenCreate creates a JWT for a user and returns its ID and value, which
+// can't be retrieved afterwards.
+func (c *Client) TokenCreate(params url.Values) (id, token string, e error) {
+	b, e := c.Post(PathTokens, params)
+	if e != nil {
+		return
+	}
+
+	entry, e := firstEntry(b)
+	if e != nil {
+		return
+	}
+
+	return contentString(entry.Content, "id"), contentString(entry.Content, "token"), nil
+}
+
+// TokenRead returns the token with the given ID among the tokens of user.
+func (c *Client) TokenRead(user, id string) (r Entry, e error) {
+	b, e := c.Get(fmt.Sprintf(PathToken, url.PathEscape(user)))
+	if e != nil {
+		return
+	}
+
+	f := Feed{}
+	e = json.Unmarshal(b, &f)
+	if e != nil {
+		return
+	}
+
+	for _, entry := range f.Entry {
+		if entry.Name == id {
+			return entry, nil
+		}
+	}
+	e = errors.New("Unexpected response from Splunk: 404 token not found")
+	return
+}
+
+// TokenStatusUpdate enables or disables a token.
+func (c *Client) TokenStatusUpdate(user, id, status string) (e error) {
+	r := url.Values{}
+	r.Set("id", id)
+	r.Set("status", status)
+
+	_, e = c.Post(fmt.Sprintf(PathToken, url.PathEscape(user)), r)
+	return
+}
+
+func (c *Client) TokenDelete(user, id string) (e error) {
+	return c.Delete(fmt.Sprintf(PathToken, url.PathEscape(user)) + "?id=" + url.QueryEscape(id))
+}
@@ -0,0 +1,57 @@
+package splunk
+
+import (
+	"encoding/xml"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// suppressEquivalentXMLDiff ignores indentation, attribute order and comments,
+// which Splunk freely rewrites when it stores views and navigation menus.
+func suppressEquivalentXMLDiff(k, old, new string, d *schema.ResourceData) bool {
+	o, err := canonicalXML(old)
+	if err != nil {
+		return false
+	}
+
+	n, err := canonicalXML(new)
+	if err != nil {
+		return false
+	}
+
+	return o == n
+}
+
+// canonicalXML renders a document as a flat list of its elements, sorted
+// attributes and trimmed text.
+func canonicalXML(s string) (string, error) {
+	var b strings.Builder
+	decoder := xml.NewDecoder(strings.NewReader(s))
+	for {
+		t, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch t := t.(type) {
+		case xml.StartElement:
+			attrs := make([]string, len(t.Attr))
+			for i, a := range t.Attr {
+				attrs[i] = a.Name.Local + "=" + a.Value
+			}
+			sort.Strings(attrs)
+			b.WriteString("<" + t.Name.Local + " " + strings.Join(attrs, " ") + ">")
+		case xml.EndElement:
+			b.WriteString("</" + t.Name.Local + ">")
+		case xml.CharData:
+			b.WriteString(strings.TrimSpace(string(t)))
+		}
+	}
+	return b.String(), nil
+}
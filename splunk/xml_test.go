@@ -0,0 +1,75 @@
+package splunk
+
+import (
+	"testing"
+)
+
+func TestSuppressEquivalentXMLDiff(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Old        string
+		New        string
+		Equivalent bool
+	}{
+		{
+			Name:       "identical",
+			Old:        `<nav><view name="search"/></nav>`,
+			New:        `<nav><view name="search"/></nav>`,
+			Equivalent: true,
+		},
+		{
+			Name:       "indentation",
+			Old:        "<nav>\n  <view name=\"search\" />\n</nav>\n",
+			New:        `<nav><view name="search"></view></nav>`,
+			Equivalent: true,
+		},
+		{
+			Name:       "attribute order",
+			Old:        `<view name="search" default="true"/>`,
+			New:        `<view default="true" name="search"/>`,
+			Equivalent: true,
+		},
+		{
+			Name:       "comments",
+			Old:        `<dashboard><!-- managed by Terraform --><label>Overview</label></dashboard>`,
+			New:        `<dashboard><label>Overview</label></dashboard>`,
+			Equivalent: true,
+		},
+		{
+			Name:       "CDATA",
+			Old:        `<query><![CDATA[index=main | head 10]]></query>`,
+			New:        `<query>index=main | head 10</query>`,
+			Equivalent: true,
+		},
+		{
+			Name:       "attribute value",
+			Old:        `<view name="search" default="true"/>`,
+			New:        `<view name="search" default="false"/>`,
+			Equivalent: false,
+		},
+		{
+			Name:       "element order",
+			Old:        `<nav><view name="search"/><view name="reports"/></nav>`,
+			New:        `<nav><view name="reports"/><view name="search"/></nav>`,
+			Equivalent: false,
+		},
+		{
+			Name:       "text",
+			Old:        `<label>Overview</label>`,
+			New:        `<label>Summary</label>`,
+			Equivalent: false,
+		},
+		{
+			Name:       "invalid",
+			Old:        `<nav>`,
+			New:        `<nav></nav>`,
+			Equivalent: false,
+		},
+	}
+
+	for _, tc := range cases {
+		if got := suppressEquivalentXMLDiff("eai_data", tc.Old, tc.New, nil); got != tc.Equivalent {
+			t.Fatalf("%s: expected %t, got %t", tc.Name, tc.Equivalent, got)
+		}
+	}
+}